@@ -0,0 +1,339 @@
+// Package apidiff compares the C# surface generated for two versions of the
+// OpenAPI specification and classifies the differences for SDK consumers.
+package apidiff
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/generator"
+)
+
+// Change kinds.
+const (
+	Added      = "added"
+	Removed    = "removed"
+	Renamed    = "renamed"
+	Changed    = "changed"
+	Deprecated = "deprecated"
+)
+
+// ModelsScope is the scope of changes to types no single client uses.
+const ModelsScope = "models"
+
+// Change is a single difference between two generated surfaces.
+type Change struct {
+	Kind string `json:"kind"`
+	// Symbol is the affected C# member, e.g. "CheckoutsClient.Create" or
+	// "Checkout.Amount".
+	Symbol   string `json:"symbol"`
+	Breaking bool   `json:"breaking"`
+	// Scope is the SumUpClient property of the client the change belongs to,
+	// e.g. "Checkouts", or ModelsScope.
+	Scope   string `json:"scope"`
+	Message string `json:"message"`
+	// Summary describes the change for a changelog, in terms of the members
+	// callers use, e.g. "Added `Readers.GetStatusAsync`".
+	Summary string `json:"summary"`
+}
+
+// Compare returns the changes from old to new, breaking changes first and then
+// ordered by symbol.
+func Compare(old, new *generator.Surface) []Change {
+	c := &comparer{clients: map[string]string{}}
+	for _, surface := range []*generator.Surface{old, new} {
+		for _, client := range surface.Clients {
+			c.clients[client.Name] = client.Property
+		}
+	}
+
+	oldClients := indexBy(old.Clients, func(client generator.SurfaceClient) string { return client.Name })
+	newClients := indexBy(new.Clients, func(client generator.SurfaceClient) string { return client.Name })
+	for name, oldClient := range oldClients {
+		newClient, ok := newClients[name]
+		if !ok {
+			c.report(Change{Kind: Removed, Symbol: name, Breaking: true, Summary: fmt.Sprintf("Removed the `%s` client", oldClient.Property)}, "client %s was removed", name)
+			continue
+		}
+		c.compareMethods(name, oldClient.Methods, newClient.Methods)
+	}
+	for name, newClient := range newClients {
+		if _, ok := oldClients[name]; !ok {
+			c.report(Change{Kind: Added, Symbol: name, Summary: fmt.Sprintf("Added the `%s` client", newClient.Property)}, "client %s was added", name)
+		}
+	}
+
+	oldTypes := indexBy(old.Types, func(t generator.SurfaceType) string { return t.Name })
+	newTypes := indexBy(new.Types, func(t generator.SurfaceType) string { return t.Name })
+	for name, oldType := range oldTypes {
+		newType, ok := newTypes[name]
+		if !ok {
+			c.report(Change{Kind: Removed, Symbol: name, Breaking: true, Summary: fmt.Sprintf("Removed %s `%s`", typeNoun(oldType), name)}, "%s %s was removed", oldType.Kind, name)
+			continue
+		}
+		c.compareTypes(oldType, newType)
+	}
+	for name, newType := range newTypes {
+		if _, ok := oldTypes[name]; !ok {
+			c.report(Change{Kind: Added, Symbol: name, Summary: fmt.Sprintf("Added %s `%s`", typeNoun(newType), name)}, "%s %s was added", newType.Kind, name)
+		}
+	}
+
+	owners := typeOwners(old, new)
+	for i := range c.changes {
+		c.changes[i].Scope = c.scope(c.changes[i].Symbol, owners)
+	}
+	sort.Slice(c.changes, func(i, j int) bool {
+		a, b := c.changes[i], c.changes[j]
+		if a.Breaking != b.Breaking {
+			return a.Breaking
+		}
+		if a.Symbol != b.Symbol {
+			return a.Symbol < b.Symbol
+		}
+		return a.Message < b.Message
+	})
+	return c.changes
+}
+
+type comparer struct {
+	changes []Change
+	// clients maps client class names to their SumUpClient property.
+	clients map[string]string
+}
+
+func (c *comparer) report(change Change, format string, args ...any) {
+	change.Message = fmt.Sprintf(format, args...)
+	c.changes = append(c.changes, change)
+}
+
+// method returns how callers invoke a method, e.g. "Readers.GetStatusAsync".
+func (c *comparer) method(client, name string) string {
+	return c.clients[client] + "." + name + "Async"
+}
+
+func (c *comparer) compareMethods(client string, oldMethods, newMethods []generator.SurfaceMethod) {
+	oldByName := indexBy(oldMethods, func(m generator.SurfaceMethod) string { return m.Name })
+	newByName := indexBy(newMethods, func(m generator.SurfaceMethod) string { return m.Name })
+
+	// A method that disappears while a new one serves the same operation was
+	// renamed, typically through x-codegen.method_name or a new operationId.
+	// Operations are matched by HTTP method and path.
+	renamedTo := map[string]string{}
+	newByOperation := map[string]string{}
+	for name, method := range newByName {
+		if _, ok := oldByName[name]; !ok {
+			newByOperation[operationKey(method)] = name
+		}
+	}
+	for name, method := range oldByName {
+		if _, ok := newByName[name]; ok {
+			continue
+		}
+		symbol := client + "." + name
+		if newName, ok := newByOperation[operationKey(method)]; ok {
+			renamedTo[newName] = name
+			c.report(Change{Kind: Renamed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("Renamed `%s` to `%s`", c.method(client, name), c.method(client, newName))}, "method %s was renamed to %s", symbol, newName)
+			c.compareMethod(client, newName, method, newByName[newName])
+			continue
+		}
+		c.report(Change{Kind: Removed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("Removed `%s`", c.method(client, name))}, "method %s (%s %s) was removed", symbol, method.HTTPMethod, method.Path)
+	}
+	for name, method := range newByName {
+		symbol := client + "." + name
+		if oldMethod, ok := oldByName[name]; ok {
+			c.compareMethod(client, name, oldMethod, method)
+			continue
+		}
+		if _, ok := renamedTo[name]; !ok {
+			c.report(Change{Kind: Added, Symbol: symbol, Summary: fmt.Sprintf("Added `%s`", c.method(client, name))}, "method %s (%s %s) was added", symbol, method.HTTPMethod, method.Path)
+		}
+	}
+}
+
+func (c *comparer) compareMethod(client, name string, old, new generator.SurfaceMethod) {
+	symbol, method := client+"."+name, c.method(client, name)
+	if old.ReturnType != new.ReturnType {
+		c.report(Change{Kind: Changed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` returns `%s` instead of `%s`", method, new.ReturnType, old.ReturnType)}, "%s returns %s instead of %s", symbol, new.ReturnType, old.ReturnType)
+	}
+	if new.Deprecated && !old.Deprecated {
+		c.report(Change{Kind: Deprecated, Symbol: symbol, Summary: fmt.Sprintf("Deprecated `%s`", method)}, "method %s was deprecated", symbol)
+	}
+
+	// Parameters are positional, so a parameter inserted in the middle shows
+	// up as changes to every position after it.
+	for i := 0; i < max(len(old.Parameters), len(new.Parameters)); i++ {
+		switch {
+		case i >= len(new.Parameters):
+			parameter := old.Parameters[i]
+			c.report(Change{Kind: Removed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` no longer takes `%s`", method, parameter.Name)}, "%s no longer takes parameter %s", symbol, parameter.Name)
+		case i >= len(old.Parameters):
+			parameter := new.Parameters[i]
+			if parameter.Optional {
+				c.report(Change{Kind: Added, Symbol: symbol, Summary: fmt.Sprintf("`%s` takes an optional `%s`", method, parameter.Name)}, "%s takes a new optional parameter %s %s", symbol, parameter.Type, parameter.Name)
+			} else {
+				c.report(Change{Kind: Added, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` requires `%s`", method, parameter.Name)}, "%s takes a new required parameter %s %s", symbol, parameter.Type, parameter.Name)
+			}
+		default:
+			oldParameter, newParameter := old.Parameters[i], new.Parameters[i]
+			switch {
+			case oldParameter.Name != newParameter.Name:
+				c.report(Change{Kind: Changed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` takes `%s %s` instead of `%s %s`", method, newParameter.Type, newParameter.Name, oldParameter.Type, oldParameter.Name)}, "%s parameter %d is %s %s instead of %s %s", symbol, i+1, newParameter.Type, newParameter.Name, oldParameter.Type, oldParameter.Name)
+			case oldParameter.Type != newParameter.Type:
+				c.report(Change{Kind: Changed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` takes `%s` as `%s` instead of `%s`", method, newParameter.Name, newParameter.Type, oldParameter.Type)}, "%s parameter %s is %s instead of %s", symbol, newParameter.Name, newParameter.Type, oldParameter.Type)
+			case oldParameter.Optional && !newParameter.Optional:
+				c.report(Change{Kind: Changed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` requires `%s`", method, newParameter.Name)}, "%s parameter %s is now required", symbol, newParameter.Name)
+			case !oldParameter.Optional && newParameter.Optional:
+				c.report(Change{Kind: Changed, Symbol: symbol, Summary: fmt.Sprintf("`%s` no longer requires `%s`", method, newParameter.Name)}, "%s parameter %s is now optional", symbol, newParameter.Name)
+			}
+		}
+	}
+}
+
+func (c *comparer) compareTypes(old, new generator.SurfaceType) {
+	name := new.Name
+	if old.Kind != new.Kind {
+		c.report(Change{Kind: Changed, Symbol: name, Breaking: true, Summary: fmt.Sprintf("`%s` is now %s instead of %s", name, typeNoun(new), typeNoun(old))}, "%s is now a %s instead of a %s", name, new.Kind, old.Kind)
+		return
+	}
+	switch {
+	case old.BaseType == new.BaseType:
+	case old.BaseType == "" && new.Kind == "class":
+		// A class gaining a base class keeps its members, which are
+		// compared below, and converts implicitly to the base.
+		c.report(Change{Kind: Changed, Symbol: name, Summary: fmt.Sprintf("`%s` derives from `%s`", name, new.BaseType)}, "%s now derives from %s", name, new.BaseType)
+	case new.BaseType == "":
+		c.report(Change{Kind: Changed, Symbol: name, Breaking: true, Summary: fmt.Sprintf("`%s` no longer derives from `%s`", name, old.BaseType)}, "%s no longer derives from %s", name, old.BaseType)
+	default:
+		c.report(Change{Kind: Changed, Symbol: name, Breaking: true, Summary: fmt.Sprintf("`%s` derives from `%s` instead of `%s`", name, new.BaseType, old.BaseType)}, "%s derives from %s instead of %s", name, new.BaseType, old.BaseType)
+	}
+	if new.Deprecated && !old.Deprecated {
+		c.report(Change{Kind: Deprecated, Symbol: name, Summary: fmt.Sprintf("Deprecated `%s`", name)}, "%s %s was deprecated", new.Kind, name)
+	}
+
+	oldMembers := indexBy(old.Members, func(member string) string { return member })
+	newMembers := indexBy(new.Members, func(member string) string { return member })
+	for member := range oldMembers {
+		if _, ok := newMembers[member]; !ok {
+			c.report(Change{Kind: Removed, Symbol: name + "." + member, Breaking: true, Summary: fmt.Sprintf("Removed enum value `%s` from `%s`", member, name)}, "enum member %s.%s was removed", name, member)
+		}
+	}
+	for member := range newMembers {
+		if _, ok := oldMembers[member]; !ok {
+			c.report(Change{Kind: Added, Symbol: name + "." + member, Summary: fmt.Sprintf("New enum value `%s` on `%s`", member, name)}, "enum member %s.%s was added", name, member)
+		}
+	}
+
+	oldProperties := indexBy(old.Properties, func(p generator.SurfaceProperty) string { return p.Name })
+	newProperties := indexBy(new.Properties, func(p generator.SurfaceProperty) string { return p.Name })
+	for property, oldProperty := range oldProperties {
+		symbol := name + "." + property
+		newProperty, ok := newProperties[property]
+		if !ok {
+			c.report(Change{Kind: Removed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("Removed property `%s` from `%s`", property, name)}, "property %s was removed", symbol)
+			continue
+		}
+		c.compareProperty(symbol, oldProperty, newProperty)
+	}
+	for property, newProperty := range newProperties {
+		if _, ok := oldProperties[property]; ok {
+			continue
+		}
+		symbol := name + "." + property
+		if newProperty.Required {
+			c.report(Change{Kind: Added, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("New required property `%s` on `%s`", property, name)}, "property %s was added and is required", symbol)
+		} else {
+			c.report(Change{Kind: Added, Symbol: symbol, Summary: fmt.Sprintf("New property `%s` on `%s`", property, name)}, "property %s was added", symbol)
+		}
+	}
+}
+
+// compareProperty reports type changes separately from required-ness flips.
+// Dropping a requirement only breaks consumers when it makes the C# type
+// nullable; adding one breaks every caller that does not set the property.
+func (c *comparer) compareProperty(symbol string, old, new generator.SurfaceProperty) {
+	oldType, newType := strings.TrimSuffix(old.Type, "?"), strings.TrimSuffix(new.Type, "?")
+	typeChanged := Change{Kind: Changed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` is `%s` instead of `%s`", symbol, new.Type, old.Type)}
+	if oldType != newType {
+		c.report(typeChanged, "property %s is %s instead of %s", symbol, new.Type, old.Type)
+	}
+	if old.JSONName != new.JSONName {
+		c.report(Change{Kind: Changed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` is serialized as `%s` instead of `%s`", symbol, new.JSONName, old.JSONName)}, "property %s is serialized as %q instead of %q", symbol, new.JSONName, old.JSONName)
+	}
+	if new.Deprecated && !old.Deprecated {
+		c.report(Change{Kind: Deprecated, Symbol: symbol, Summary: fmt.Sprintf("Deprecated `%s`", symbol)}, "property %s was deprecated", symbol)
+	}
+	switch {
+	case !old.Required && new.Required:
+		c.report(Change{Kind: Changed, Symbol: symbol, Breaking: true, Summary: fmt.Sprintf("`%s` is now required", symbol)}, "property %s is now required", symbol)
+	case old.Required && !new.Required:
+		c.report(Change{Kind: Changed, Symbol: symbol, Breaking: oldType == newType && old.Type != new.Type, Summary: fmt.Sprintf("`%s` is now optional", symbol)}, "property %s is now optional", symbol)
+	case oldType == newType && old.Type != new.Type:
+		c.report(typeChanged, "property %s is %s instead of %s", symbol, new.Type, old.Type)
+	}
+}
+
+// scope returns the client property a symbol belongs to: its own client for
+// clients and methods, and otherwise the only client whose signatures use the
+// type.
+func (c *comparer) scope(symbol string, owners map[string]string) string {
+	typeName, _, _ := strings.Cut(symbol, ".")
+	if property, ok := c.clients[typeName]; ok {
+		return property
+	}
+	if owner := owners[typeName]; owner != "" {
+		return owner
+	}
+	return ModelsScope
+}
+
+var identifierPattern = regexp.MustCompile(`\w+`)
+
+// typeOwners maps the types referenced by method signatures to the client
+// using them, or to "" when several clients do.
+func typeOwners(surfaces ...*generator.Surface) map[string]string {
+	owners := map[string]string{}
+	for _, surface := range surfaces {
+		for _, client := range surface.Clients {
+			for _, method := range client.Methods {
+				signature := []string{method.ReturnType}
+				for _, parameter := range method.Parameters {
+					signature = append(signature, parameter.Type)
+				}
+				for _, name := range identifierPattern.FindAllString(strings.Join(signature, " "), -1) {
+					if owner, ok := owners[name]; ok && owner != client.Property {
+						owners[name] = ""
+						continue
+					}
+					owners[name] = client.Property
+				}
+			}
+		}
+	}
+	return owners
+}
+
+func typeNoun(t generator.SurfaceType) string {
+	switch t.Kind {
+	case "enum":
+		return "enum"
+	case "options":
+		return "options"
+	default:
+		return "model"
+	}
+}
+
+func operationKey(method generator.SurfaceMethod) string {
+	return method.HTTPMethod + " " + method.Path
+}
+
+func indexBy[T any](items []T, key func(T) string) map[string]T {
+	index := make(map[string]T, len(items))
+	for _, item := range items {
+		index[key(item)] = item
+	}
+	return index
+}
@@ -0,0 +1,169 @@
+package apidiff
+
+import (
+	"bytes"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/generator"
+)
+
+func testSurface() *generator.Surface {
+	return &generator.Surface{
+		Clients: []generator.SurfaceClient{{
+			Name: "CheckoutsClient",
+			Methods: []generator.SurfaceMethod{
+				{
+					Name: "Get", HTTPMethod: "GET", Path: "/v0.1/checkouts/{id}", ReturnType: "ApiResponse<Checkout>",
+					Parameters: []generator.SurfaceParameter{{Name: "id", Type: "string"}},
+				},
+				{
+					Name: "List", HTTPMethod: "GET", Path: "/v0.1/checkouts", ReturnType: "ApiResponse<IEnumerable<Checkout>>",
+					Parameters: []generator.SurfaceParameter{{Name: "options", Type: "CheckoutsListOptions?", Optional: true}},
+				},
+			},
+		}},
+		Types: []generator.SurfaceType{
+			{Name: "Checkout", Kind: "class", Properties: []generator.SurfaceProperty{
+				{Name: "Amount", JSONName: "amount", Type: "float?"},
+				{Name: "Id", JSONName: "id", Type: "string", Required: true},
+				{Name: "Mandate", JSONName: "mandate", Type: "Mandate?"},
+			}},
+			{Name: "CheckoutStatus", Kind: "enum", Members: []string{"Failed", "Paid", "Pending"}},
+		},
+	}
+}
+
+func TestCompare_ClassifiesChanges(t *testing.T) {
+	old, updated := testSurface(), testSurface()
+	client := &updated.Clients[0]
+	client.Methods[0].Parameters = append(client.Methods[0].Parameters, generator.SurfaceParameter{Name: "options", Type: "CheckoutsGetOptions?", Optional: true})
+	client.Methods[1].Name = "ListAll"
+	checkout := &updated.Types[0]
+	checkout.Properties[0] = generator.SurfaceProperty{Name: "Amount", JSONName: "amount", Type: "decimal?"}
+	checkout.Properties[1] = generator.SurfaceProperty{Name: "Id", JSONName: "id", Type: "string?"}
+	checkout.Properties[2] = generator.SurfaceProperty{Name: "Channel", JSONName: "channel", Type: "string?"}
+	updated.Types[1].Members = []string{"Expired", "Paid", "Pending"}
+
+	got := map[string]bool{}
+	for _, change := range Compare(old, updated) {
+		got[change.Kind+" "+change.Symbol] = change.Breaking
+	}
+	want := map[string]bool{
+		"added CheckoutsClient.Get":     false,
+		"renamed CheckoutsClient.List":  true,
+		"changed Checkout.Amount":       true,
+		"changed Checkout.Id":           true,
+		"removed Checkout.Mandate":      true,
+		"added Checkout.Channel":        false,
+		"removed CheckoutStatus.Failed": true,
+		"added CheckoutStatus.Expired":  false,
+	}
+	if !maps.Equal(got, want) {
+		t.Fatalf("Compare() = %v, want %v", got, want)
+	}
+}
+
+func TestCompare_RequiredFlips(t *testing.T) {
+	tests := []struct {
+		name         string
+		old, new     generator.SurfaceProperty
+		wantBreaking bool
+	}{
+		{"now required", generator.SurfaceProperty{Type: "string?"}, generator.SurfaceProperty{Type: "string", Required: true}, true},
+		{"now optional and nullable", generator.SurfaceProperty{Type: "long", Required: true}, generator.SurfaceProperty{Type: "long?"}, true},
+		{"now optional with the same type", generator.SurfaceProperty{Type: "string?", Required: true}, generator.SurfaceProperty{Type: "string?"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.old.Name, test.new.Name = "Value", "Value"
+			old := &generator.Surface{Types: []generator.SurfaceType{{Name: "Model", Kind: "class", Properties: []generator.SurfaceProperty{test.old}}}}
+			updated := &generator.Surface{Types: []generator.SurfaceType{{Name: "Model", Kind: "class", Properties: []generator.SurfaceProperty{test.new}}}}
+			changes := Compare(old, updated)
+			if len(changes) != 1 || changes[0].Breaking != test.wantBreaking {
+				t.Fatalf("Compare() = %v, want one change with breaking = %t", changes, test.wantBreaking)
+			}
+		})
+	}
+}
+
+func TestCompare_BaseTypes(t *testing.T) {
+	tests := []struct {
+		name         string
+		old, new     string
+		wantBreaking bool
+	}{
+		{"base added", "", "TransactionBase", false},
+		{"base changed", "TransactionBase", "Transaction", true},
+		{"base removed", "TransactionBase", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			old := &generator.Surface{Types: []generator.SurfaceType{{Name: "TransactionFull", Kind: "class", BaseType: test.old}}}
+			updated := &generator.Surface{Types: []generator.SurfaceType{{Name: "TransactionFull", Kind: "class", BaseType: test.new}}}
+			changes := Compare(old, updated)
+			if len(changes) != 1 || changes[0].Kind != Changed || changes[0].Breaking != test.wantBreaking {
+				t.Fatalf("Compare() = %v, want one change with breaking = %t", changes, test.wantBreaking)
+			}
+		})
+	}
+}
+
+func TestSuggest_FollowsReleasePleasePreMajorSettings(t *testing.T) {
+	breaking := []Change{{Kind: Removed, Breaking: true}}
+	feature := []Change{{Kind: Added}}
+	fix := []Change{{Kind: Changed}}
+	policy := Policy{BumpMinorPreMajor: true, BumpPatchForMinorPreMajor: true}
+
+	tests := []struct {
+		changes []Change
+		policy  Policy
+		current string
+		want    Suggestion
+	}{
+		{breaking, policy, "0.0.18", Suggestion{Bump: Minor, Current: "0.0.18", Next: "0.1.0"}},
+		{feature, policy, "0.0.18", Suggestion{Bump: Patch, Current: "0.0.18", Next: "0.0.19"}},
+		{breaking, Policy{}, "0.0.18", Suggestion{Bump: Major, Current: "0.0.18", Next: "1.0.0"}},
+		{breaking, policy, "v1.2.3", Suggestion{Bump: Major, Current: "v1.2.3", Next: "2.0.0"}},
+		{feature, policy, "1.2.3-beta.1", Suggestion{Bump: Minor, Current: "1.2.3-beta.1", Next: "1.3.0"}},
+		{fix, policy, "", Suggestion{Bump: Patch}},
+		{nil, policy, "1.2.3", Suggestion{Bump: None, Current: "1.2.3", Next: "1.2.3"}},
+	}
+	for _, test := range tests {
+		got, err := Suggest(test.changes, test.policy, test.current)
+		if err != nil {
+			t.Fatalf("Suggest(%q) error = %v", test.current, err)
+		}
+		if got != test.want {
+			t.Errorf("Suggest(%v, %q) = %+v, want %+v", test.changes, test.current, got, test.want)
+		}
+	}
+
+	if _, err := Suggest(breaking, policy, "1.2"); err == nil {
+		t.Fatalf("Suggest() accepted an incomplete version")
+	}
+}
+
+func TestWriteMarkdown_GroupsBreakingChanges(t *testing.T) {
+	changes := Compare(testSurface(), &generator.Surface{Clients: testSurface().Clients})
+	suggestion, err := Suggest(changes, Policy{BumpMinorPreMajor: true}, "0.0.18")
+	if err != nil {
+		t.Fatalf("Suggest() error = %v", err)
+	}
+	var out bytes.Buffer
+	if err := WriteMarkdown(&out, changes, suggestion); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	for _, want := range []string{
+		"Suggested version bump: **minor** (0.0.18 → 0.1.0)",
+		"### Breaking changes\n\n- **removed** `Checkout`: class Checkout was removed\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("WriteMarkdown() = %s, want it to contain %q", out.String(), want)
+		}
+	}
+	if strings.Contains(out.String(), "Non-breaking") {
+		t.Fatalf("WriteMarkdown() printed an empty non-breaking section:\n%s", out.String())
+	}
+}
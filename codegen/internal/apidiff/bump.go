@@ -0,0 +1,132 @@
+package apidiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Bump is a semantic version increment.
+type Bump int
+
+const (
+	None Bump = iota
+	Patch
+	Minor
+	Major
+)
+
+func (b Bump) String() string {
+	switch b {
+	case Patch:
+		return "patch"
+	case Minor:
+		return "minor"
+	case Major:
+		return "major"
+	default:
+		return "none"
+	}
+}
+
+// MarshalText encodes the bump by name.
+func (b Bump) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// Policy mirrors the release-please settings that change how commits map to
+// version bumps before 1.0.0.
+type Policy struct {
+	// BumpMinorPreMajor turns breaking changes into minor bumps before 1.0.0.
+	BumpMinorPreMajor bool `json:"bump-minor-pre-major"`
+	// BumpPatchForMinorPreMajor turns features into patch bumps before 1.0.0.
+	BumpPatchForMinorPreMajor bool `json:"bump-patch-for-minor-pre-major"`
+}
+
+// LoadPolicy reads the bump settings from a release-please-config.json.
+func LoadPolicy(path string) (Policy, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Policy{}, fmt.Errorf("read release-please config: %w", err)
+	}
+	var policy Policy
+	if err := json.Unmarshal(content, &policy); err != nil {
+		return Policy{}, fmt.Errorf("decode release-please config %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Suggestion is the version bump implied by a set of changes.
+type Suggestion struct {
+	Bump Bump `json:"bump"`
+	// Current and Next are empty when no current version was given.
+	Current string `json:"current,omitempty"`
+	Next    string `json:"next,omitempty"`
+}
+
+// Suggest picks the bump release-please would apply for changes, which is
+// major for breaking changes, minor for additions and deprecations and patch
+// otherwise, adjusted by policy while current is below 1.0.0. current may be
+// empty.
+func Suggest(changes []Change, policy Policy, current string) (Suggestion, error) {
+	bump := None
+	for _, change := range changes {
+		switch {
+		case change.Breaking:
+			bump = max(bump, Major)
+		case change.Kind == Added || change.Kind == Deprecated:
+			bump = max(bump, Minor)
+		default:
+			bump = max(bump, Patch)
+		}
+	}
+	if current == "" {
+		return Suggestion{Bump: bump}, nil
+	}
+
+	version, err := parseVersion(current)
+	if err != nil {
+		return Suggestion{}, err
+	}
+	if version[0] == 0 {
+		if bump == Major && policy.BumpMinorPreMajor {
+			bump = Minor
+		} else if bump == Minor && policy.BumpPatchForMinorPreMajor {
+			bump = Patch
+		}
+	}
+	next := version
+	switch bump {
+	case Major:
+		next = [3]int{version[0] + 1, 0, 0}
+	case Minor:
+		next = [3]int{version[0], version[1] + 1, 0}
+	case Patch:
+		next = [3]int{version[0], version[1], version[2] + 1}
+	}
+	return Suggestion{Bump: bump, Current: current, Next: fmt.Sprintf("%d.%d.%d", next[0], next[1], next[2])}, nil
+}
+
+// parseVersion parses MAJOR.MINOR.PATCH, ignoring a leading "v" and any
+// pre-release or build suffix.
+func parseVersion(value string) ([3]int, error) {
+	core := strings.TrimPrefix(strings.TrimSpace(value), "v")
+	if index := strings.IndexAny(core, "-+"); index >= 0 {
+		core = core[:index]
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return [3]int{}, fmt.Errorf("version %q is not MAJOR.MINOR.PATCH", value)
+	}
+	var version [3]int
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return [3]int{}, fmt.Errorf("version %q is not MAJOR.MINOR.PATCH", value)
+		}
+		version[i] = number
+	}
+	return version, nil
+}
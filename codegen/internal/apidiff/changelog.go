@@ -0,0 +1,64 @@
+package apidiff
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// changelogSections are the CHANGELOG.md headings entries are filed under,
+// in release-please order.
+var changelogSections = []struct {
+	title   string
+	matches func(Change) bool
+}{
+	{"⚠ BREAKING CHANGES", func(c Change) bool { return c.Breaking }},
+	{"Features", func(c Change) bool { return !c.Breaking && c.Kind != Deprecated }},
+	{"Deprecations", func(c Change) bool { return !c.Breaking && c.Kind == Deprecated }},
+}
+
+// WriteChangelog writes the changes as CHANGELOG.md sections, one entry per
+// change scoped by client, e.g. "* **Readers:** Added `Readers.GetStatusAsync`".
+// heading, when set, is written first as the release heading.
+func WriteChangelog(w io.Writer, changes []Change, heading string) error {
+	var b strings.Builder
+	if heading != "" {
+		fmt.Fprintf(&b, "## %s\n", heading)
+	}
+
+	for _, section := range changelogSections {
+		var entries []Change
+		seen := map[string]struct{}{}
+		for _, change := range changes {
+			key := change.Scope + "\x00" + change.Summary
+			if _, ok := seen[key]; ok || !section.matches(change) {
+				continue
+			}
+			seen[key] = struct{}{}
+			entries = append(entries, change)
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		// Client scopes come first in alphabetical order, shared models last.
+		sort.SliceStable(entries, func(i, j int) bool {
+			a, b := entries[i], entries[j]
+			if (a.Scope == ModelsScope) != (b.Scope == ModelsScope) {
+				return b.Scope == ModelsScope
+			}
+			if a.Scope != b.Scope {
+				return a.Scope < b.Scope
+			}
+			return a.Summary < b.Summary
+		})
+
+		fmt.Fprintf(&b, "\n\n### %s\n\n", section.title)
+		for _, entry := range entries {
+			fmt.Fprintf(&b, "* **%s:** %s\n", entry.Scope, entry.Summary)
+		}
+	}
+
+	_, err := io.WriteString(w, strings.TrimPrefix(b.String(), "\n\n"))
+	return err
+}
@@ -0,0 +1,54 @@
+package apidiff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/generator"
+)
+
+func TestWriteChangelog_GroupsEntriesByScope(t *testing.T) {
+	old := testSurface()
+	old.Clients[0].Property = "Checkouts"
+	old.Clients = append(old.Clients, generator.SurfaceClient{Name: "ReadersClient", Property: "Readers"})
+	old.Types = append(old.Types, generator.SurfaceType{Name: "Currency", Kind: "enum", Members: []string{"Eur"}})
+
+	updated := testSurface()
+	updated.Clients[0].Property = "Checkouts"
+	updated.Clients[0].Methods[1].Deprecated = true
+	updated.Clients = append(updated.Clients, generator.SurfaceClient{Name: "ReadersClient", Property: "Readers", Methods: []generator.SurfaceMethod{{
+		Name: "GetStatus", HTTPMethod: "GET", Path: "/v0.1/readers/{id}/status", ReturnType: "ApiResponse<ReaderStatus>",
+	}}})
+	updated.Types[0].Properties = updated.Types[0].Properties[:2]
+	updated.Types[1].Members = append(updated.Types[1].Members, "Expired")
+	updated.Types = append(updated.Types, generator.SurfaceType{Name: "Currency", Kind: "enum", Members: []string{"Eur", "Usd"}})
+
+	var out bytes.Buffer
+	if err := WriteChangelog(&out, Compare(old, updated), "[0.1.0](https://example.com/compare/v0.0.18...v0.1.0) (2026-10-18)"); err != nil {
+		t.Fatalf("WriteChangelog() error = %v", err)
+	}
+	want := "## [0.1.0](https://example.com/compare/v0.0.18...v0.1.0) (2026-10-18)\n" +
+		"\n\n### ⚠ BREAKING CHANGES\n\n" +
+		"* **Checkouts:** Removed property `Mandate` from `Checkout`\n" +
+		"\n\n### Features\n\n" +
+		"* **Readers:** Added `Readers.GetStatusAsync`\n" +
+		"* **models:** New enum value `Expired` on `CheckoutStatus`\n" +
+		"* **models:** New enum value `Usd` on `Currency`\n" +
+		"\n\n### Deprecations\n\n" +
+		"* **Checkouts:** Deprecated `Checkouts.ListAsync`\n"
+	if out.String() != want {
+		t.Fatalf("WriteChangelog() =\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestWriteChangelog_OmitsHeadingAndEmptySections(t *testing.T) {
+	var out bytes.Buffer
+	changes := []Change{{Kind: Added, Scope: "Checkouts", Summary: "Added `Checkouts.ListAsync`"}}
+	if err := WriteChangelog(&out, changes, ""); err != nil {
+		t.Fatalf("WriteChangelog() error = %v", err)
+	}
+	want := "### Features\n\n* **Checkouts:** Added `Checkouts.ListAsync`\n"
+	if out.String() != want {
+		t.Fatalf("WriteChangelog() = %q, want %q", out.String(), want)
+	}
+}
@@ -0,0 +1,61 @@
+package apidiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown writes the changes as a Markdown section suitable for a pull
+// request description.
+func WriteMarkdown(w io.Writer, changes []Change, suggestion Suggestion) error {
+	var b strings.Builder
+	b.WriteString("## SDK API changes\n\n")
+	if len(changes) == 0 {
+		b.WriteString("No changes to the generated C# API.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	fmt.Fprintf(&b, "Suggested version bump: **%s**", suggestion.Bump)
+	if suggestion.Next != "" && suggestion.Next != suggestion.Current {
+		fmt.Fprintf(&b, " (%s → %s)", suggestion.Current, suggestion.Next)
+	}
+	b.WriteString("\n")
+
+	writeSection := func(title string, breaking bool) {
+		count := 0
+		for _, change := range changes {
+			if change.Breaking != breaking {
+				continue
+			}
+			if count == 0 {
+				fmt.Fprintf(&b, "\n### %s\n\n", title)
+			}
+			count++
+			fmt.Fprintf(&b, "- **%s** `%s`: %s\n", change.Kind, change.Symbol, change.Message)
+		}
+	}
+	writeSection("Breaking changes", true)
+	writeSection("Non-breaking changes", false)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJSON writes the changes and the suggested bump as indented JSON.
+func WriteJSON(w io.Writer, changes []Change, suggestion Suggestion) error {
+	if changes == nil {
+		changes = []Change{}
+	}
+	encoded, err := json.MarshalIndent(struct {
+		Suggestion Suggestion `json:"suggestion"`
+		Changes    []Change   `json:"changes"`
+	}{suggestion, changes}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode changes: %w", err)
+	}
+	_, err = w.Write(append(encoded, '\n'))
+	return err
+}
@@ -0,0 +1,272 @@
+// Package config loads codegen.yaml, the versioned file holding the settings
+// of a generation run.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/generator"
+)
+
+// Version is the only supported value of the version key.
+const Version = 1
+
+var (
+	identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	namespacePattern  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	typePattern       = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*(<[A-Za-z0-9_.,<> ]+>)?(\[\])?$`)
+)
+
+// mappableTypes are the OpenAPI types whose C# type can be overridden.
+var mappableTypes = []string{"boolean", "integer", "number", "string"}
+
+// Config is the content of codegen.yaml. Relative paths are resolved against
+// the directory of the file.
+type Config struct {
+	Version int `yaml:"version"`
+	// Spec is a path, http(s) URL or "-" for stdin.
+	Spec          string   `yaml:"spec"`
+	Overlays      []string `yaml:"overlays"`
+	Output        string   `yaml:"output"`
+	TestingOutput string   `yaml:"testing_output"`
+	Namespace     string   `yaml:"namespace"`
+	// Templates is a directory of templates overriding the embedded ones.
+	Templates string                 `yaml:"templates"`
+	Filters   Filters                `yaml:"filters"`
+	Types     map[string]TypeMapping `yaml:"types"`
+	Names     Names                  `yaml:"names"`
+	Features  Features               `yaml:"features"`
+	Samples   Samples                `yaml:"samples"`
+	// ReleaseConfig is the release-please-config.json whose bump settings
+	// diff and changelog apply.
+	ReleaseConfig string `yaml:"release_config"`
+}
+
+// Filters selects the operations and models to generate.
+type Filters struct {
+	Include Selector `yaml:"include"`
+	Exclude Selector `yaml:"exclude"`
+	// PruneUnreferenced skips component schemas no operation references.
+	PruneUnreferenced bool `yaml:"prune_unreferenced"`
+}
+
+// Selector matches operations by tag, operationId glob or path prefix.
+type Selector struct {
+	Tags       []string `yaml:"tags"`
+	Operations []string `yaml:"operations"`
+	Paths      []string `yaml:"paths"`
+}
+
+// TypeMapping is the C# type generated for an OpenAPI type and format.
+type TypeMapping struct {
+	Type      string `yaml:"type"`
+	ValueType bool   `yaml:"value_type"`
+}
+
+// Names overrides generated names.
+type Names struct {
+	// DefaultClient names the client of operations without tags.
+	DefaultClient string `yaml:"default_client"`
+	// Clients, Methods and Models are keyed by tag, operationId and component
+	// schema name.
+	Clients map[string]string `yaml:"clients"`
+	Methods map[string]string `yaml:"methods"`
+	Models  map[string]string `yaml:"models"`
+}
+
+// Features toggles optional output. Unset toggles are enabled, except
+// ReadWriteModels and RecordModels, which opt in to different model shapes.
+type Features struct {
+	PublicAPI            *bool `yaml:"public_api"`
+	JSONDocumentFallback *bool `yaml:"json_document_fallback"`
+	AllOfInheritance     *bool `yaml:"allof_inheritance"`
+	ReadWriteModels      bool  `yaml:"read_write_models"`
+	RecordModels         bool  `yaml:"record_models"`
+}
+
+// Samples configures the code sample catalog.
+type Samples struct {
+	Module         string `yaml:"module"`
+	SDKVersion     string `yaml:"sdk_version"`
+	SDKVersionFile string `yaml:"sdk_version_file"`
+}
+
+// Load reads and validates the configuration at path.
+func Load(path string) (*Config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	config, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	config.resolvePaths(filepath.Dir(path))
+	return config, nil
+}
+
+// Parse decodes and validates a YAML or JSON configuration. Unknown keys are
+// rejected.
+func Parse(content []byte) (*Config, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("config is empty")
+		}
+		return nil, fmt.Errorf("decode config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Validate reports every invalid setting, keyed by its path in the file.
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(key, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+	}
+
+	switch c.Version {
+	case Version:
+	case 0:
+		fail("version", "is required (set it to %d)", Version)
+	default:
+		fail("version", "unsupported version %d (this codegen reads version %d)", c.Version, Version)
+	}
+	if c.Namespace != "" && !namespacePattern.MatchString(c.Namespace) {
+		fail("namespace", "%q is not a C# namespace", c.Namespace)
+	}
+
+	for _, selector := range []struct {
+		key string
+		Selector
+	}{{"filters.include", c.Filters.Include}, {"filters.exclude", c.Filters.Exclude}} {
+		for i, pattern := range selector.Operations {
+			if _, err := path.Match(pattern, ""); err != nil {
+				fail(fmt.Sprintf("%s.operations[%d]", selector.key, i), "invalid glob %q", pattern)
+			}
+		}
+		for i, prefix := range selector.Paths {
+			if !strings.HasPrefix(prefix, "/") {
+				fail(fmt.Sprintf("%s.paths[%d]", selector.key, i), "path prefix %q must start with /", prefix)
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(c.Types) {
+		mapping := c.Types[key]
+		openAPIType, _, _ := strings.Cut(key, "/")
+		if !contains(mappableTypes, openAPIType) {
+			fail("types."+key, "unsupported type %q (use %s, optionally followed by /format)", openAPIType, strings.Join(mappableTypes, ", "))
+		}
+		if !typePattern.MatchString(mapping.Type) {
+			fail("types."+key+".type", "%q is not a C# type", mapping.Type)
+		}
+	}
+
+	if c.Names.DefaultClient != "" && !identifierPattern.MatchString(c.Names.DefaultClient) {
+		fail("names.default_client", "%q is not a C# identifier", c.Names.DefaultClient)
+	}
+	for _, names := range []struct {
+		key    string
+		values map[string]string
+	}{{"names.clients", c.Names.Clients}, {"names.methods", c.Names.Methods}, {"names.models", c.Names.Models}} {
+		for _, key := range sortedKeys(names.values) {
+			if value := names.values[key]; !identifierPattern.MatchString(value) {
+				fail(names.key+"."+key, "%q is not a C# identifier", value)
+			}
+		}
+	}
+
+	if c.Samples.SDKVersion != "" && c.Samples.SDKVersionFile != "" {
+		fail("samples", "sdk_version and sdk_version_file are mutually exclusive")
+	}
+	return errors.Join(errs...)
+}
+
+// Generator returns the generator settings of the configuration.
+func (c *Config) Generator() generator.Config {
+	config := generator.Config{
+		OutputDir:        c.Output,
+		TestingOutputDir: c.TestingOutput,
+		Namespace:        c.Namespace,
+		TemplatesDir:     c.Templates,
+		Filter: generator.Filter{
+			Include: generator.Selector(c.Filters.Include),
+			Exclude: generator.Selector(c.Filters.Exclude),
+		},
+		PruneUnreferenced: c.Filters.PruneUnreferenced,
+		DefaultClient:     c.Names.DefaultClient,
+		ClientNames:       c.Names.Clients,
+		MethodNames:       c.Names.Methods,
+		ModelNames:        c.Names.Models,
+		SkipPublicAPI:     !enabled(c.Features.PublicAPI),
+		StrictTypes:       !enabled(c.Features.JSONDocumentFallback),
+		FlattenAllOf:      !enabled(c.Features.AllOfInheritance),
+		ReadWriteModels:   c.Features.ReadWriteModels,
+		RecordModels:      c.Features.RecordModels,
+		SampleModule:      c.Samples.Module,
+	}
+	if len(c.Types) > 0 {
+		config.TypeMappings = make(map[string]generator.TypeMapping, len(c.Types))
+		for key, mapping := range c.Types {
+			config.TypeMappings[key] = generator.TypeMapping(mapping)
+		}
+	}
+	return config
+}
+
+func (c *Config) resolvePaths(dir string) {
+	resolve := func(value string) string {
+		if value == "" || value == "-" || filepath.IsAbs(value) || strings.Contains(value, "://") {
+			return value
+		}
+		return filepath.Join(dir, value)
+	}
+	c.Spec = resolve(c.Spec)
+	for i := range c.Overlays {
+		c.Overlays[i] = resolve(c.Overlays[i])
+	}
+	c.Output = resolve(c.Output)
+	c.TestingOutput = resolve(c.TestingOutput)
+	c.Templates = resolve(c.Templates)
+	c.Samples.SDKVersionFile = resolve(c.Samples.SDKVersionFile)
+	c.ReleaseConfig = resolve(c.ReleaseConfig)
+}
+
+func enabled(toggle *bool) bool {
+	return toggle == nil || *toggle
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/generator"
+)
+
+func TestLoad_ResolvesPathsAndBuildsGeneratorConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "codegen.yaml")
+	content := `version: 1
+spec: ../openapi.json
+overlays: [sdk.overlay.yaml, https://example.com/overlay.yaml]
+output: ../src/SumUp
+namespace: Acme.Payments
+filters:
+  include:
+    tags: [Checkouts, Readers]
+  exclude:
+    operations: ["*Deprecated"]
+types:
+  string/date-time: { type: DateTime, value_type: true }
+names:
+  default_client: Service
+  methods: { CreateCheckout: Start }
+features:
+  public_api: false
+  allof_inheritance: false
+  read_write_models: true
+  record_models: true
+samples:
+  module: Acme.Payments
+release_config: ../release-please-config.json
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if want := filepath.Join(dir, "..", "openapi.json"); config.Spec != want {
+		t.Errorf("Spec = %q, want %q", config.Spec, want)
+	}
+	if want := []string{filepath.Join(dir, "sdk.overlay.yaml"), "https://example.com/overlay.yaml"}; strings.Join(config.Overlays, ",") != strings.Join(want, ",") {
+		t.Errorf("Overlays = %q, want %q", config.Overlays, want)
+	}
+	if want := filepath.Join(dir, "..", "release-please-config.json"); config.ReleaseConfig != want {
+		t.Errorf("ReleaseConfig = %q, want %q", config.ReleaseConfig, want)
+	}
+
+	got := config.Generator()
+	if got.Namespace != "Acme.Payments" || got.DefaultClient != "Service" || got.MethodNames["CreateCheckout"] != "Start" || got.SampleModule != "Acme.Payments" {
+		t.Errorf("Generator() = %+v", got)
+	}
+	if !got.SkipPublicAPI || got.StrictTypes || !got.FlattenAllOf || !got.ReadWriteModels || !got.RecordModels {
+		t.Errorf("Generator() feature toggles: SkipPublicAPI = %t, StrictTypes = %t, FlattenAllOf = %t, ReadWriteModels = %t, RecordModels = %t", got.SkipPublicAPI, got.StrictTypes, got.FlattenAllOf, got.ReadWriteModels, got.RecordModels)
+	}
+	if got.TypeMappings["string/date-time"] != (generator.TypeMapping{Type: "DateTime", ValueType: true}) {
+		t.Errorf("TypeMappings = %v", got.TypeMappings)
+	}
+	if strings.Join(got.Filter.Include.Tags, ",") != "Checkouts,Readers" || strings.Join(got.Filter.Exclude.Operations, ",") != "*Deprecated" {
+		t.Errorf("Filter = %+v", got.Filter)
+	}
+}
+
+func TestParse_ReportsInvalidSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"empty", "", []string{"config is empty"}},
+		{"unknown key", "version: 1\noutptu: src\n", []string{"field outptu not found"}},
+		{"missing version", "namespace: SumUp\n", []string{"version: is required"}},
+		{"future version", "version: 2\n", []string{"version: unsupported version 2"}},
+		{
+			"every invalid setting",
+			`version: 1
+namespace: Sum Up
+filters:
+  include:
+    operations: ["[Create"]
+    paths: [v0.1/checkouts]
+types:
+  object: { type: JsonObject }
+  string/uuid: { type: "" }
+names:
+  methods: { CreateCheckout: "Create checkout" }
+samples:
+  sdk_version: 1.0.0
+  sdk_version_file: SumUp.csproj
+`,
+			[]string{
+				`namespace: "Sum Up" is not a C# namespace`,
+				`filters.include.operations[0]: invalid glob "[Create"`,
+				`filters.include.paths[0]: path prefix "v0.1/checkouts" must start with /`,
+				`types.object: unsupported type "object"`,
+				`types.string/uuid.type: "" is not a C# type`,
+				`names.methods.CreateCheckout: "Create checkout" is not a C# identifier`,
+				`samples: sdk_version and sdk_version_file are mutually exclusive`,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse([]byte(test.content))
+			if err == nil {
+				t.Fatalf("Parse() error = nil, want %q", test.want)
+			}
+			for _, want := range test.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Parse() error = %v, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParse_AcceptsJSON(t *testing.T) {
+	config, err := Parse([]byte(`{"version": 1, "names": {"models": {"Checkout": "Payment"}}}`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if config.Names.Models["Checkout"] != "Payment" {
+		t.Fatalf("Names.Models = %v", config.Names.Models)
+	}
+}
+
+func TestLoad_RepositoryConfig(t *testing.T) {
+	config, err := Load(filepath.Join("..", "..", "codegen.yaml"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if _, err := os.Stat(config.Spec); err != nil {
+		t.Fatalf("spec: %v", err)
+	}
+	if _, err := os.Stat(config.ReleaseConfig); err != nil {
+		t.Fatalf("release config: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package diag
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity ranks how much a diagnostic affects the generated SDK.
+type Severity int
+
+const (
+	// Info diagnostics are worth knowing about but do not change the output.
+	Info Severity = iota
+	// Warning diagnostics degrade the generated SDK.
+	Warning
+	// Error diagnostics break generation or produce unusable code.
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Error:
+		return "error"
+	case Warning:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ParseSeverity parses the names returned by Severity.String.
+func ParseSeverity(value string) (Severity, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "error":
+		return Error, nil
+	case "warning":
+		return Warning, nil
+	case "info":
+		return Info, nil
+	default:
+		return Info, fmt.Errorf("unknown severity %q (use error, warning or info)", value)
+	}
+}
+
+// Diagnostic describes a spec construct the generator could not handle fully.
+type Diagnostic struct {
+	// Code identifies the kind of diagnostic, e.g. "unsupported-parameter".
+	Code     string   `json:"code"`
+	Severity Severity `json:"severity"`
+	// Pointer is the JSON pointer of the spec location, e.g.
+	// "#/paths/~1v0.1~1checkouts/post/requestBody".
+	Pointer string `json:"pointer"`
+	// Line and Column locate the pointer in the spec source; zero when unknown.
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s: %s [%s]", d.Severity, location(d.Pointer, d.Line, d.Column), d.Message, d.Code)
+}
+
+// SpecError is an error caused by a construct of the spec. It records where
+// the construct is so callers can point the API team at it.
+type SpecError struct {
+	// Pointer is the JSON pointer of the spec location.
+	Pointer string
+	// Line and Column locate the pointer in the spec source; zero when unknown.
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *SpecError) Error() string {
+	return location(e.Pointer, e.Line, e.Column) + ": " + e.Err.Error()
+}
+
+func (e *SpecError) Unwrap() error {
+	return e.Err
+}
+
+func location(pointer string, line, column int) string {
+	if line == 0 {
+		return pointer
+	}
+	return fmt.Sprintf("%s (line %d, column %d)", pointer, line, column)
+}
+
+// Count returns how many diagnostics are at or above the severity.
+func Count(diagnostics []Diagnostic, atLeast Severity) int {
+	count := 0
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity >= atLeast {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,183 @@
+package examples
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"go.yaml.in/yaml/v4"
+)
+
+// Direction selects which side of an exchange an example is built for.
+type Direction int
+
+const (
+	// Request examples skip readOnly properties and only fill required or
+	// documented properties, keeping generated request payloads minimal.
+	Request Direction = iota
+	// Response examples skip writeOnly properties and fill every property.
+	Response
+)
+
+const maxDepth = 6
+
+// Value returns an example value for the schema. Documented examples, defaults,
+// constants and enums win over synthesized values.
+func Value(proxy *base.SchemaProxy, direction Direction) any {
+	return value(proxy, direction, 0)
+}
+
+// JSON returns Value encoded as compact JSON.
+func JSON(proxy *base.SchemaProxy, direction Direction) string {
+	encoded, err := json.Marshal(Value(proxy, direction))
+	if err != nil {
+		return "{}"
+	}
+	return string(encoded)
+}
+
+// NodeJSON encodes a YAML node as compact JSON, returning an empty string when
+// the node is nil or cannot be decoded.
+func NodeJSON(node *yaml.Node) string {
+	value, ok := Decode(node)
+	if !ok {
+		return ""
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+// Decode converts a YAML node into plain Go values.
+func Decode(node *yaml.Node) (any, bool) {
+	if node == nil {
+		return nil, false
+	}
+	var value any
+	if err := node.Decode(&value); err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+func value(proxy *base.SchemaProxy, direction Direction, depth int) any {
+	if proxy == nil || depth > maxDepth {
+		return map[string]any{}
+	}
+	schema := proxy.Schema()
+	if schema == nil {
+		return map[string]any{}
+	}
+	for _, node := range append(append([]*yaml.Node{}, schema.Examples...), schema.Example, schema.Default, schema.Const) {
+		if value, ok := Decode(node); ok {
+			return value
+		}
+	}
+	if len(schema.Enum) > 0 {
+		if value, ok := Decode(schema.Enum[0]); ok {
+			return value
+		}
+	}
+	if len(schema.AllOf) > 0 {
+		merged := map[string]any{}
+		for _, part := range schema.AllOf {
+			if partValue, ok := value(part, direction, depth+1).(map[string]any); ok {
+				for key, item := range partValue {
+					merged[key] = item
+				}
+			}
+		}
+		if len(merged) > 0 {
+			return merged
+		}
+	}
+	if len(schema.OneOf) > 0 {
+		return value(schema.OneOf[0], direction, depth+1)
+	}
+	if len(schema.AnyOf) > 0 {
+		return value(schema.AnyOf[0], direction, depth+1)
+	}
+	if hasType(schema, "array") && schema.Items != nil && schema.Items.IsA() {
+		return []any{value(schema.Items.A, direction, depth+1)}
+	}
+	if hasType(schema, "object") || (schema.Properties != nil && schema.Properties.Len() > 0) {
+		result := map[string]any{}
+		required := make(map[string]struct{}, len(schema.Required))
+		for _, name := range schema.Required {
+			required[name] = struct{}{}
+		}
+		if schema.Properties != nil {
+			for name, property := range schema.Properties.FromOldest() {
+				propertySchema := property.Schema()
+				if skipProperty(propertySchema, direction) {
+					continue
+				}
+				_, isRequired := required[name]
+				if direction == Response || isRequired || hasExample(propertySchema) {
+					result[name] = value(property, direction, depth+1)
+				}
+			}
+		}
+		return result
+	}
+	switch {
+	case hasType(schema, "string"):
+		return stringValue(schema.Format)
+	case hasType(schema, "integer"):
+		return 1
+	case hasType(schema, "number"):
+		return 10.0
+	case hasType(schema, "boolean"):
+		return true
+	default:
+		return map[string]any{}
+	}
+}
+
+func stringValue(format string) string {
+	switch format {
+	case "date-time":
+		return "2025-01-01T12:00:00Z"
+	case "date":
+		return "2025-01-01"
+	case "uuid":
+		return "00000000-0000-0000-0000-000000000001"
+	case "email":
+		return "merchant@example.com"
+	case "uri", "url":
+		return "https://example.com"
+	default:
+		return "example"
+	}
+}
+
+func skipProperty(schema *base.Schema, direction Direction) bool {
+	if schema == nil {
+		return false
+	}
+	switch direction {
+	case Request:
+		return schema.ReadOnly != nil && *schema.ReadOnly
+	case Response:
+		return schema.WriteOnly != nil && *schema.WriteOnly
+	}
+	return false
+}
+
+func hasExample(schema *base.Schema) bool {
+	return schema != nil && (schema.Example != nil || len(schema.Examples) > 0 || schema.Default != nil || schema.Const != nil || len(schema.Enum) > 0)
+}
+
+func hasType(schema *base.Schema, target string) bool {
+	if schema == nil {
+		return false
+	}
+	for _, t := range schema.Type {
+		if strings.EqualFold(t, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package examples
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+)
+
+const examplesSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {},
+  "components": {
+    "schemas": {
+      "Reader": {
+        "type": "object",
+        "required": ["name"],
+        "properties": {
+          "id": { "type": "string", "readOnly": true, "example": "rdr_1" },
+          "name": { "type": "string" },
+          "pairing_code": { "type": "string", "writeOnly": true },
+          "status": { "type": "string", "enum": ["paired", "expired"] },
+          "created_at": { "type": "string", "format": "date-time" }
+        }
+      }
+    }
+  }
+}`
+
+func TestJSON(t *testing.T) {
+	document, err := libopenapi.NewDocument([]byte(examplesSpec))
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	model, err := document.BuildV3Model()
+	if err != nil {
+		t.Fatalf("BuildV3Model() error = %v", err)
+	}
+	schema := model.Model.Components.Schemas.GetOrZero("Reader")
+
+	if got, want := JSON(schema, Request), `{"name":"example","status":"paired"}`; got != want {
+		t.Fatalf("JSON(Request) = %q, want %q", got, want)
+	}
+	if got, want := JSON(schema, Response), `{"created_at":"2025-01-01T12:00:00Z","id":"rdr_1","name":"example","status":"paired"}`; got != want {
+		t.Fatalf("JSON(Response) = %q, want %q", got, want)
+	}
+}
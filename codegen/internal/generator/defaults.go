@@ -0,0 +1,100 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"go.yaml.in/yaml/v4"
+)
+
+// schemaDefault returns the scalar default of the schema as written in the
+// spec, and the C# literal initializing a property of typeName to it. The
+// literal is empty when the type has none, e.g. for dates, in which case the
+// default is only documented.
+func (g *Generator) schemaDefault(schema *base.Schema, typeName string) (string, string) {
+	if schema == nil || schema.Default == nil {
+		return "", ""
+	}
+	node := schema.Default
+	if node.Kind != yaml.ScalarNode || node.ShortTag() == "!!null" {
+		return "", ""
+	}
+	value := node.Value
+	return value, g.defaultLiteral(schema, strings.TrimSuffix(typeName, "?"), value)
+}
+
+func (g *Generator) defaultLiteral(schema *base.Schema, typeName, value string) string {
+	switch typeName {
+	case "string":
+		return csharpString(value)
+	case "bool":
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return strconv.FormatBool(parsed)
+		}
+	case "int", "long":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return ""
+		}
+		if typeName == "long" {
+			return value + "L"
+		}
+		return value
+	case "float", "double", "decimal":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return ""
+		}
+		return value + map[string]string{"float": "f", "double": "d", "decimal": "m"}[typeName]
+	}
+	for _, member := range g.buildEnumValues(schema) {
+		if member.Value == value {
+			return typeName + "." + member.Name
+		}
+	}
+	return ""
+}
+
+// documentRequestDefaults drops the initializers of the models request
+// bodies send, directly or through their properties and base types, so that
+// defaults stay documented but only the values callers set are serialized.
+// Fields such as tip_timeout are only valid alongside others, and an
+// initialized default would be sent with every request.
+func documentRequestDefaults(models []modelTemplateData, clients []clientTemplateData) {
+	index := make(map[string]int, len(models))
+	for i, model := range models {
+		index[model.Name] = i
+	}
+	pending := []string{}
+	for _, client := range clients {
+		for _, operation := range client.Operations {
+			if operation.Body != nil {
+				pending = append(pending, typeIdentifiers(operation.Body.TypeName)...)
+			}
+		}
+	}
+	seen := map[string]bool{}
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		i, ok := index[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		for j, property := range models[i].Properties {
+			models[i].Properties[j].DefaultLiteral = ""
+			pending = append(pending, typeIdentifiers(property.TypeName)...)
+		}
+		if models[i].BaseType != "" {
+			pending = append(pending, models[i].BaseType)
+		}
+	}
+}
+
+// typeIdentifiers returns the type names a C# type is built of, e.g. Item
+// and Tag for IDictionary<string, IEnumerable<Item>>? and Tag.
+func typeIdentifiers(typeName string) []string {
+	return strings.FieldsFunc(typeName, func(r rune) bool {
+		return r == '<' || r == '>' || r == ',' || r == ' ' || r == '?' || r == '[' || r == ']'
+	})
+}
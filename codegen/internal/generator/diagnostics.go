@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+)
+
+// Diagnostics returns the diagnostics collected by the last Run or Samples
+// call, ordered by pointer.
+func (g *Generator) Diagnostics() []diag.Diagnostic {
+	diagnostics := append([]diag.Diagnostic(nil), g.diagnostics...)
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].Pointer != diagnostics[j].Pointer {
+			return diagnostics[i].Pointer < diagnostics[j].Pointer
+		}
+		return diagnostics[i].Code < diagnostics[j].Code
+	})
+	return diagnostics
+}
+
+// warn records a warning at pointer, or at the current location when pointer
+// is empty. Schemas resolved more than once are only reported once. JsonDocument
+// fallbacks are recorded as errors under StrictTypes.
+func (g *Generator) warn(pointer, code, format string, args ...any) {
+	severity := diag.Warning
+	if code == "json-document-fallback" && g.config.StrictTypes {
+		severity = diag.Error
+	}
+	g.report(severity, pointer, code, format, args...)
+}
+
+// inform records an informational diagnostic, located like warn's.
+func (g *Generator) inform(pointer, code, format string, args ...any) {
+	g.report(diag.Info, pointer, code, format, args...)
+}
+
+func (g *Generator) report(severity diag.Severity, pointer, code, format string, args ...any) {
+	if pointer == "" {
+		pointer = g.location
+	}
+	line, column := spec.Locate(g.doc, pointer)
+	diagnostic := diag.Diagnostic{
+		Code:     code,
+		Severity: severity,
+		Pointer:  pointer,
+		Line:     line,
+		Column:   column,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	for _, existing := range g.diagnostics {
+		if existing == diagnostic {
+			return
+		}
+	}
+	g.diagnostics = append(g.diagnostics, diagnostic)
+}
+
+// typeErrors returns the first JsonDocument fallback recorded under
+// StrictTypes as a *diag.SpecError.
+func (g *Generator) typeErrors() error {
+	for _, diagnostic := range g.Diagnostics() {
+		if diagnostic.Severity == diag.Error {
+			return &diag.SpecError{
+				Pointer: diagnostic.Pointer,
+				Line:    diagnostic.Line,
+				Column:  diagnostic.Column,
+				Err:     fmt.Errorf("%s (JsonDocument fallbacks are disabled)", diagnostic.Message),
+			}
+		}
+	}
+	return nil
+}
+
+// at moves the current location to pointer and returns a function restoring
+// the previous one, to be deferred by the caller.
+func (g *Generator) at(pointer string) func() {
+	previous := g.location
+	g.location = pointer
+	return func() { g.location = previous }
+}
+
+// errorf returns a *diag.SpecError at pointer, or at the current location when
+// pointer is empty.
+func (g *Generator) errorf(pointer, format string, args ...any) error {
+	if pointer == "" {
+		pointer = g.location
+	}
+	line, column := spec.Locate(g.doc, pointer)
+	return &diag.SpecError{Pointer: pointer, Line: line, Column: column, Err: fmt.Errorf(format, args...)}
+}
+
+// wrap attaches the current location to err unless it already carries one.
+func (g *Generator) wrap(err error) error {
+	var specErr *diag.SpecError
+	if err == nil || errors.As(err, &specErr) {
+		return err
+	}
+	line, column := spec.Locate(g.doc, g.location)
+	return &diag.SpecError{Pointer: g.location, Line: line, Column: column, Err: err}
+}
@@ -0,0 +1,241 @@
+package generator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
+)
+
+func TestBuildClients_ReportsDiagnosticsForDegradedConstructs(t *testing.T) {
+	const spec = `{
+	  "openapi": "3.0.3",
+	  "info": {
+	    "title": "test",
+	    "version": "1.0.0"
+	  },
+	  "paths": {
+	    "/v0.1/widgets/{id}": {
+	      "put": {
+	        "operationId": "UpdateWidget",
+	        "tags": ["Widgets"],
+	        "parameters": [
+	          {
+	            "name": "id",
+	            "in": "path",
+	            "required": true,
+	            "schema": { "type": "string" }
+	          },
+	          {
+	            "name": "session",
+	            "in": "cookie",
+	            "schema": { "type": "string" }
+	          }
+	        ],
+	        "requestBody": {
+	          "content": {
+	            "text/plain": {
+	              "schema": { "type": "string" }
+	            }
+	          }
+	        },
+	        "responses": {
+	          "200": {
+	            "description": "ok",
+	            "content": {
+	              "application/json": {
+	                "schema": { "$ref": "#/components/schemas/Widget" }
+	              }
+	            }
+	          },
+	          "400": {
+	            "description": "bad request",
+	            "content": {
+	              "application/json": {
+	                "schema": {
+	                  "oneOf": [
+	                    { "$ref": "#/components/schemas/Widget" },
+	                    { "type": "string" }
+	                  ]
+	                }
+	              }
+	            }
+	          }
+	        }
+	      }
+	    }
+	  },
+	  "components": {
+	    "schemas": {
+	      "Widget": {
+	        "type": "object",
+	        "properties": {
+	          "tags": { "type": "array" }
+	        }
+	      }
+	    }
+	  }
+	}`
+
+	doc := mustBuildV3Document(t, spec)
+
+	g := New(Config{Namespace: "SumUp"})
+	if _, err := g.buildModels(doc); err != nil {
+		t.Fatalf("buildModels() error = %v", err)
+	}
+	if _, err := g.buildClients(doc); err != nil {
+		t.Fatalf("buildClients() error = %v", err)
+	}
+
+	want := []diag.Diagnostic{
+		{Code: "json-document-fallback", Pointer: "#/components/schemas/Widget/properties/tags"},
+		{Code: "unsupported-parameter", Pointer: "#/paths/~1v0.1~1widgets~1{id}/put/parameters/1"},
+		{Code: "unsupported-request-body", Pointer: "#/paths/~1v0.1~1widgets~1{id}/put/requestBody"},
+		{Code: "json-document-fallback", Pointer: "#/paths/~1v0.1~1widgets~1{id}/put/responses/400/content/application~1json/schema"},
+	}
+	got := g.Diagnostics()
+	if len(got) != len(want) {
+		t.Fatalf("Diagnostics() returned %d diagnostics, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i].Code != want[i].Code || got[i].Pointer != want[i].Pointer {
+			t.Fatalf("diagnostic %d = %s %s, want %s %s", i, got[i].Code, got[i].Pointer, want[i].Code, want[i].Pointer)
+		}
+		if got[i].Line == 0 || got[i].Column == 0 {
+			t.Fatalf("diagnostic %d has no source location", i)
+		}
+		if got[i].Severity != diag.Warning {
+			t.Fatalf("diagnostic %d severity = %s, want %s", i, got[i].Severity, diag.Warning)
+		}
+	}
+}
+
+func TestBuildClients_ReportsMissingJSONRequestSchema(t *testing.T) {
+	const spec = `{
+	  "openapi": "3.0.3",
+	  "info": {
+	    "title": "test",
+	    "version": "1.0.0"
+	  },
+	  "paths": {
+	    "/v0.1/widgets": {
+	      "post": {
+	        "operationId": "CreateWidget",
+	        "tags": ["Widgets"],
+	        "requestBody": {
+	          "content": {
+	            "application/json": {}
+	          }
+	        },
+	        "responses": {
+	          "204": { "description": "created" }
+	        }
+	      }
+	    }
+	  }
+	}`
+
+	doc := mustBuildV3Document(t, spec)
+
+	g := New(Config{Namespace: "SumUp"})
+	if _, err := g.buildClients(doc); err != nil {
+		t.Fatalf("buildClients() error = %v", err)
+	}
+
+	got := g.Diagnostics()
+	if len(got) != 1 {
+		t.Fatalf("Diagnostics() returned %d diagnostics, want 1: %v", len(got), got)
+	}
+	const wantMessage = "application/json request body declares no schema; the operation is generated without a body"
+	if got[0].Message != wantMessage {
+		t.Fatalf("diagnostic message = %q, want %q", got[0].Message, wantMessage)
+	}
+}
+
+func TestSamples_ReturnsSpecErrorForMissingOperationID(t *testing.T) {
+	const spec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {
+    "/v0.1/widgets": {
+      "get": {
+        "tags": ["Widgets"],
+        "responses": { "204": { "description": "ok" } }
+      }
+    }
+  }
+}`
+
+	doc := mustBuildV3Document(t, spec)
+
+	g := New(Config{Namespace: "SumUp"})
+	_, err := g.Samples(doc, "1.0.0")
+	var specErr *diag.SpecError
+	if !errors.As(err, &specErr) {
+		t.Fatalf("Samples() error = %v, want a *diag.SpecError", err)
+	}
+	if specErr.Pointer != "#/paths/~1v0.1~1widgets/get" {
+		t.Fatalf("pointer = %q, want %q", specErr.Pointer, "#/paths/~1v0.1~1widgets/get")
+	}
+	if specErr.Line != 6 || specErr.Column != 7 {
+		t.Fatalf("location = %d:%d, want 6:7", specErr.Line, specErr.Column)
+	}
+	const wantMessage = "#/paths/~1v0.1~1widgets/get (line 6, column 7): missing operationId for GET /v0.1/widgets"
+	if specErr.Error() != wantMessage {
+		t.Fatalf("Error() = %q, want %q", specErr.Error(), wantMessage)
+	}
+}
+
+func TestBuild_TuplesOfDifferentTypesFallBackToJsonDocument(t *testing.T) {
+	const spec = `{
+	  "openapi": "3.1.0",
+	  "info": { "title": "test", "version": "1.0.0" },
+	  "paths": {
+	    "/v0.1/points": {
+	      "get": {
+	        "operationId": "GetPoint",
+	        "tags": ["Points"],
+	        "responses": {
+	          "200": {
+	            "description": "ok",
+	            "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Point" } } }
+	          }
+	        }
+	      }
+	    }
+	  },
+	  "components": {
+	    "schemas": {
+	      "Point": {
+	        "type": "object",
+	        "properties": {
+	          "label": {
+	            "type": "array",
+	            "prefixItems": [{ "type": "string" }, { "type": "integer" }]
+	          }
+	        }
+	      }
+	    }
+	  }
+	}`
+
+	doc := mustBuildV3Document(t, spec)
+	label := doc.Components.Schemas.GetOrZero("Point").Schema().Properties.GetOrZero("label").Schema()
+	if reason := JSONDocumentFallback(label); reason != "is a tuple of different types" {
+		t.Fatalf("JSONDocumentFallback() = %q, want the tuple reason", reason)
+	}
+
+	built, err := New(Config{Namespace: "SumUp"}).build(doc)
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+	if got := propertyType(findModel(t, built.models, "Point").Properties, "Label"); got != "IEnumerable<JsonDocument>?" {
+		t.Fatalf("Label type = %q, want IEnumerable<JsonDocument>?", got)
+	}
+
+	_, err = New(Config{Namespace: "SumUp", StrictTypes: true}).build(doc)
+	var specErr *diag.SpecError
+	if !errors.As(err, &specErr) || specErr.Pointer != "#/components/schemas/Point/properties/label" {
+		t.Fatalf("build() error = %v, want a *diag.SpecError at the tuple", err)
+	}
+}
@@ -0,0 +1,20 @@
+package generator
+
+// CodegenExtension is the vendor extension holding the generator settings of
+// an operation or schema.
+const CodegenExtension = "x-codegen"
+
+// Keys of the CodegenExtension mapping.
+const (
+	// MethodNameExtension names the client method of an operation.
+	MethodNameExtension = "method_name"
+	// PartialUpdateExtension marks a schema as a partial update outside of
+	// PATCH request bodies.
+	PartialUpdateExtension = "partial_update"
+	// PatchBuilderExtension gives the patch builder format of an operation
+	// with a JSON request body.
+	PatchBuilderExtension = "patch_builder"
+)
+
+// CodegenExtensionKeys lists the CodegenExtension keys the generator reads.
+var CodegenExtensionKeys = []string{MethodNameExtension, PartialUpdateExtension, PatchBuilderExtension}
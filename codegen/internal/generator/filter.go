@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"path"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// Filter selects operations: those matching Include, or every operation when
+// Include is empty, minus those matching Exclude.
+type Filter struct {
+	Include Selector
+	Exclude Selector
+}
+
+// Selector matches operations by tag, operationId glob or path prefix. An
+// operation matches when any of the criteria does.
+type Selector struct {
+	Tags       []string
+	Operations []string
+	Paths      []string
+}
+
+// Matches reports whether the operation at path passes the filter.
+func (f Filter) Matches(path string, op *v3.Operation) bool {
+	if !f.Include.empty() && !f.Include.matches(path, op) {
+		return false
+	}
+	return !f.Exclude.matches(path, op)
+}
+
+// active reports whether the filter excludes anything.
+func (f Filter) active() bool {
+	return !f.Include.empty() || !f.Exclude.empty()
+}
+
+func (s Selector) empty() bool {
+	return len(s.Tags) == 0 && len(s.Operations) == 0 && len(s.Paths) == 0
+}
+
+func (s Selector) matches(rawPath string, op *v3.Operation) bool {
+	for _, tag := range s.Tags {
+		if tag == operationTag(op) {
+			return true
+		}
+	}
+	if op != nil && op.OperationId != "" {
+		for _, pattern := range s.Operations {
+			if matched, _ := path.Match(pattern, op.OperationId); matched {
+				return true
+			}
+		}
+	}
+	for _, prefix := range s.Paths {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if rawPath == prefix || strings.HasPrefix(rawPath, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,221 @@
+package generator
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
+)
+
+const configuredSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {
+    "/v0.1/checkouts": {
+      "get": {
+        "tags": ["Checkouts"],
+        "operationId": "ListCheckouts",
+        "responses": { "200": { "description": "ok", "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Checkout" } } } } }
+      },
+      "post": {
+        "tags": ["Checkouts"],
+        "operationId": "CreateCheckout",
+        "responses": { "201": { "description": "ok", "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Checkout" } } } } }
+      }
+    },
+    "/v0.1/readers": {
+      "get": {
+        "tags": ["Readers"],
+        "operationId": "ListReaders",
+        "responses": { "200": { "description": "ok" } }
+      }
+    },
+    "/v0.1/health": {
+      "get": {
+        "operationId": "GetHealth",
+        "responses": { "200": { "description": "ok" } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Checkout": {
+        "type": "object",
+        "properties": {
+          "id": { "type": "string", "format": "uuid" },
+          "created_at": { "type": "string", "format": "date-time" },
+          "amount": { "type": "number" },
+          "metadata": { "oneOf": [{ "type": "string" }, { "type": "integer" }] },
+          "fees": { "type": "array", "items": { "$ref": "#/components/schemas/Money" } }
+        }
+      },
+      "Money": {
+        "type": "object",
+        "properties": { "value": { "type": "integer" } }
+      },
+      "Reader": {
+        "type": "object",
+        "properties": { "id": { "type": "string" } }
+      }
+    }
+  }
+}`
+
+func TestFilter_Matches(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+	list := doc.Paths.PathItems.GetOrZero("/v0.1/checkouts").Get
+	create := doc.Paths.PathItems.GetOrZero("/v0.1/checkouts").Post
+	health := doc.Paths.PathItems.GetOrZero("/v0.1/health").Get
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   map[string]bool
+	}{
+		{"empty", Filter{}, map[string]bool{"ListCheckouts": true, "CreateCheckout": true, "GetHealth": true}},
+		{"include tag", Filter{Include: Selector{Tags: []string{"Checkouts"}}}, map[string]bool{"ListCheckouts": true, "CreateCheckout": true, "GetHealth": false}},
+		{"include default tag", Filter{Include: Selector{Tags: []string{"Core"}}}, map[string]bool{"ListCheckouts": false, "CreateCheckout": false, "GetHealth": true}},
+		{"exclude glob", Filter{Exclude: Selector{Operations: []string{"List*"}}}, map[string]bool{"ListCheckouts": false, "CreateCheckout": true, "GetHealth": true}},
+		{"include path prefix", Filter{Include: Selector{Paths: []string{"/v0.1/checkouts/"}}}, map[string]bool{"ListCheckouts": true, "CreateCheckout": true, "GetHealth": false}},
+		{"include and exclude", Filter{Include: Selector{Tags: []string{"Checkouts"}}, Exclude: Selector{Operations: []string{"Create*"}}}, map[string]bool{"ListCheckouts": true, "CreateCheckout": false, "GetHealth": false}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := map[string]bool{
+				"ListCheckouts":  test.filter.Matches("/v0.1/checkouts", list),
+				"CreateCheckout": test.filter.Matches("/v0.1/checkouts", create),
+				"GetHealth":      test.filter.Matches("/v0.1/health", health),
+			}
+			for id, want := range test.want {
+				if got[id] != want {
+					t.Errorf("Matches(%s) = %t, want %t", id, got[id], want)
+				}
+			}
+		})
+	}
+
+	if (Filter{Include: Selector{Paths: []string{"/v0.1/check"}}}).Matches("/v0.1/checkouts", list) {
+		t.Fatalf("Matches() treated a partial segment as a path prefix")
+	}
+}
+
+func TestBuildModels_PrunesModelsOfFilteredOperations(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+
+	g := New(Config{Namespace: "SumUp", Filter: Filter{Include: Selector{Tags: []string{"Checkouts"}}}})
+	models, err := g.buildModels(doc)
+	if err != nil {
+		t.Fatalf("buildModels() error = %v", err)
+	}
+	var names []string
+	for _, model := range models {
+		names = append(names, model.Name)
+	}
+	if strings.Join(names, ",") != "Checkout,Money" {
+		t.Fatalf("models = %v, want Checkout and the Money model it references", names)
+	}
+
+	g = New(Config{Namespace: "SumUp", Filter: Filter{Exclude: Selector{Paths: []string{"/"}}}})
+	if _, err := g.buildClients(doc); err == nil || !strings.Contains(err.Error(), "filters exclude every operation") {
+		t.Fatalf("buildClients() error = %v, want filters exclude every operation", err)
+	}
+}
+
+func TestBuildClients_AppliesConfiguredNamesAndFilter(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+
+	g := New(Config{
+		Namespace:     "SumUp",
+		Filter:        Filter{Exclude: Selector{Tags: []string{"Readers"}}},
+		DefaultClient: "Service",
+		ClientNames:   map[string]string{"Checkouts": "Payments"},
+		MethodNames:   map[string]string{"CreateCheckout": "Start"},
+		ModelNames:    map[string]string{"Checkout": "PaymentCheckout"},
+	})
+	if _, err := g.buildModels(doc); err != nil {
+		t.Fatalf("buildModels() error = %v", err)
+	}
+	clients, err := g.buildClients(doc)
+	if err != nil {
+		t.Fatalf("buildClients() error = %v", err)
+	}
+
+	var got []string
+	for _, client := range clients {
+		for _, operation := range client.Operations {
+			got = append(got, client.ClientName+"."+operation.MethodName+" -> "+operation.ResponseType)
+		}
+	}
+	want := []string{
+		"Payments.ListCheckouts -> PaymentCheckout",
+		"Payments.Start -> PaymentCheckout",
+		"Service.GetHealth -> JsonDocument",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("operations = %q, want %q", got, want)
+	}
+}
+
+func TestBuildModels_UsesTypeMappings(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+
+	g := New(Config{Namespace: "SumUp", TypeMappings: map[string]TypeMapping{
+		"string/date-time": {Type: "DateTime", ValueType: true},
+		"string/uuid":      {Type: "string"},
+		"number":           {Type: "double", ValueType: true},
+	}})
+	models, err := g.buildModels(doc)
+	if err != nil {
+		t.Fatalf("buildModels() error = %v", err)
+	}
+
+	checkout := findModel(t, models, "Checkout")
+	for name, want := range map[string]string{"CreatedAt": "DateTime?", "Id": "string?", "Amount": "double?"} {
+		if got := propertyType(checkout.Properties, name); got != want {
+			t.Errorf("%s type = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestRun_StrictTypesRejectsJsonDocumentFallbacks(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+
+	err := New(Config{OutputDir: t.TempDir(), Namespace: "SumUp", StrictTypes: true}).Run(doc)
+	var specErr *diag.SpecError
+	if !errors.As(err, &specErr) {
+		t.Fatalf("Run() error = %v, want a *diag.SpecError", err)
+	}
+	if specErr.Pointer != "#/components/schemas/Checkout/properties/metadata" {
+		t.Fatalf("error pointer = %q", specErr.Pointer)
+	}
+}
+
+func TestRun_TemplatesDirOverridesTemplatesByName(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+	templates := t.TempDir()
+	override := `// custom {{ .ApiVersion }}`
+	if err := os.WriteFile(filepath.Join(templates, "api_version.tmpl"), []byte(override), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	output := t.TempDir()
+
+	if err := New(Config{OutputDir: output, Namespace: "SumUp", TemplatesDir: templates, SkipPublicAPI: true}).Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	version, err := os.ReadFile(filepath.Join(output, "Http", "ApiVersion.g.cs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(version) != "// custom 1.0.0" {
+		t.Fatalf("ApiVersion.g.cs = %q, want the overriding template", version)
+	}
+	if _, err := os.Stat(filepath.Join(output, "Models", "Checkout.g.cs")); err != nil {
+		t.Fatalf("embedded model template was not used: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(output, publicAPIFile)); !os.IsNotExist(err) {
+		t.Fatalf("%s written despite SkipPublicAPI", publicAPIFile)
+	}
+}
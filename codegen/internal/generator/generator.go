@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -17,7 +18,9 @@ import (
 	"github.com/pb33f/libopenapi/orderedmap"
 	"go.yaml.in/yaml/v4"
 
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
 	"github.com/sumup/sumup-dotnet/codegen/internal/naming"
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
 )
 
 //go:embed templates/*.tmpl
@@ -29,6 +32,7 @@ const (
 	schemaKindAlias schemaKind = iota
 	schemaKindObject
 	schemaKindEnum
+	schemaKindPatch
 )
 
 type schemaUsage int
@@ -53,6 +57,55 @@ type schemaTypeInfo struct {
 type Config struct {
 	OutputDir string
 	Namespace string
+	// TestingOutputDir, when set, receives the generated SumUp.Testing fake routes.
+	TestingOutputDir string
+	// TemplatesDir, when set, holds templates replacing the embedded ones of
+	// the same file name.
+	TemplatesDir string
+	// Filter selects the operations to generate.
+	Filter Filter
+	// TypeMappings replaces the C# type of primitive schemas, keyed by the
+	// OpenAPI type or "type/format", e.g. "string/date-time".
+	TypeMappings map[string]TypeMapping
+	// DefaultClient names the client of untagged operations (defaults to "Core").
+	DefaultClient string
+	// ClientNames, MethodNames and ModelNames override generated names, keyed
+	// by tag, operationId and component schema name.
+	ClientNames map[string]string
+	MethodNames map[string]string
+	ModelNames  map[string]string
+	// PruneUnreferenced skips component schemas no operation references.
+	PruneUnreferenced bool
+	// FlattenAllOf merges the properties of every allOf member into the
+	// model instead of deriving it from a leading component object.
+	FlattenAllOf bool
+	// ReadWriteModels splits models with readOnly or writeOnly properties
+	// into input variants without the readOnly ones for request bodies and
+	// output models without the writeOnly ones for responses.
+	ReadWriteModels bool
+	// RecordModels renders class models as records with init accessors and
+	// required members for the required properties.
+	RecordModels bool
+	// SkipPublicAPI disables the PublicAPI.g.txt snapshot.
+	SkipPublicAPI bool
+	// StrictTypes fails generation instead of falling back to JsonDocument
+	// for schemas without a C# equivalent.
+	StrictTypes bool
+	// SampleModule is the package recorded in the sample catalog (defaults to
+	// "SumUp").
+	SampleModule string
+}
+
+// ErrNoOperations is returned when the configured filter excludes every
+// operation of the document.
+var ErrNoOperations = errors.New("filters exclude every operation")
+
+// TypeMapping is the C# type generated for a primitive schema.
+type TypeMapping struct {
+	Type string
+	// ValueType reports whether Type is a struct, which makes optional
+	// values nullable with "?".
+	ValueType bool
 }
 
 // Generator renders the SumUp .NET SDK from the OpenAPI spec.
@@ -63,57 +116,65 @@ type Generator struct {
 	modelNames   map[string]struct{}
 	errorModels  map[string]struct{}
 	optionNames  map[string]struct{}
+	// partialUpdates holds the pointers of the PATCH request body schemas.
+	partialUpdates map[string]struct{}
+	// updateVariants holds the components whose PATCH request bodies take a
+	// separate partial update variant, as they are used elsewhere too.
+	updateVariants map[string]struct{}
+	// patchBuilders maps "format resource" to the name of the patch builder.
+	patchBuilders map[string]string
+	// inputVariants maps "suffix component" to the name of the input variant.
+	inputVariants map[string]string
+	// writing reports whether the models being resolved are request input.
+	writing     bool
+	diagnostics []diag.Diagnostic
+	// doc is the document being generated, used to locate diagnostics.
+	doc *v3.Document
+	// location is the JSON pointer of the spec element being generated.
+	location string
 }
 
 // New returns a new Generator.
 func New(config Config) *Generator {
 	return &Generator{
-		config:      config,
-		schemaTypes: map[string]*schemaTypeInfo{},
-		modelNames:  map[string]struct{}{},
-		errorModels: map[string]struct{}{},
-		optionNames: map[string]struct{}{},
+		config:        config,
+		schemaTypes:   map[string]*schemaTypeInfo{},
+		modelNames:    map[string]struct{}{},
+		errorModels:   map[string]struct{}{},
+		optionNames:   map[string]struct{}{},
+		patchBuilders: map[string]string{},
+		inputVariants: map[string]string{},
 	}
 }
 
-// Run executes the generator.
-func (g *Generator) Run(doc *v3.Document) error {
-	if g.config.OutputDir == "" {
-		return fmt.Errorf("output directory is required")
-	}
-
-	g.inlineModels = nil
-	g.modelNames = map[string]struct{}{}
-	g.errorModels = map[string]struct{}{}
-	g.optionNames = map[string]struct{}{}
+// sdk is the intermediate representation of the generated SDK. Building it
+// is separate from rendering: Run renders it to C#, Samples to code samples,
+// Surface to the public API and IR to the public representation.
+type sdk struct {
+	apiVersion string
+	models     []modelTemplateData
+	clients    []clientTemplateData
+	options    []optionsTemplateData
+}
 
+// build resolves the models, clients and options of doc.
+func (g *Generator) build(doc *v3.Document) (*sdk, error) {
+	g.reset()
 	if g.config.Namespace == "" {
 		g.config.Namespace = "SumUp"
 	}
 
-	if err := os.MkdirAll(g.config.OutputDir, 0o755); err != nil {
-		return fmt.Errorf("create output: %w", err)
-	}
-	if err := g.cleanOutputDir(); err != nil {
-		return fmt.Errorf("clean output: %w", err)
-	}
-
-	tmpl, err := template.New("clients").ParseFS(templateFS, "templates/*.tmpl")
-	if err != nil {
-		return fmt.Errorf("parse templates: %w", err)
-	}
-
 	models, err := g.buildModels(doc)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
 	clients, err := g.buildClients(doc)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := g.typeErrors(); err != nil {
+		return nil, err
 	}
-
-	options := g.collectOperationOptions(clients)
 
 	if len(g.inlineModels) > 0 {
 		models = append(models, g.inlineModels...)
@@ -121,44 +182,139 @@ func (g *Generator) Run(doc *v3.Document) error {
 			return models[i].Name < models[j].Name
 		})
 	}
-
 	for i := range models {
 		if _, ok := g.errorModels[models[i].Name]; ok {
 			models[i].EmitToString = true
 			models[i].UsesJson = true
 		}
 	}
+	documentRequestDefaults(models, clients)
+	linkBaseTypes(models)
+
+	return &sdk{
+		apiVersion: apiVersionFromSpec(doc),
+		models:     models,
+		clients:    clients,
+		options:    g.collectOperationOptions(clients),
+	}, nil
+}
+
+// linkBaseTypes marks the models others derive from and gives derived
+// models the properties of their ancestors. Derived models keep additional
+// properties in the extension data of the first ancestor declaring it.
+func linkBaseTypes(models []modelTemplateData) {
+	index := make(map[string]int, len(models))
+	for i, model := range models {
+		index[model.Name] = i
+	}
+	for i := range models {
+		seen := map[string]bool{models[i].Name: true}
+		for baseType := models[i].BaseType; baseType != "" && !seen[baseType]; {
+			seen[baseType] = true
+			j, ok := index[baseType]
+			if !ok {
+				break
+			}
+			models[j].IsBase = true
+			models[i].InheritedProperties = append(models[i].InheritedProperties, models[j].Properties...)
+			if models[j].HasExtensionData {
+				models[i].HasExtensionData = false
+			}
+			baseType = models[j].BaseType
+		}
+		sort.SliceStable(models[i].InheritedProperties, func(a, b int) bool {
+			return models[i].InheritedProperties[a].JsonName < models[i].InheritedProperties[b].JsonName
+		})
+	}
+}
+
+// Run executes the generator.
+func (g *Generator) Run(doc *v3.Document) error {
+	if g.config.OutputDir == "" {
+		return fmt.Errorf("output directory is required")
+	}
 
-	if err := g.renderModels(tmpl, models); err != nil {
+	// Parse templates and build the SDK first so a broken templates directory
+	// or spec leaves the output untouched.
+	tmpl, err := g.parseTemplates()
+	if err != nil {
 		return err
 	}
-	if err := g.renderOptions(tmpl, options); err != nil {
+	built, err := g.build(doc)
+	if err != nil {
 		return err
 	}
 
-	for _, client := range clients {
+	if err := os.MkdirAll(g.config.OutputDir, 0o755); err != nil {
+		return fmt.Errorf("create output: %w", err)
+	}
+	if err := cleanGeneratedFiles(g.config.OutputDir); err != nil {
+		return fmt.Errorf("clean output: %w", err)
+	}
+
+	if err := g.renderModels(tmpl, built.models); err != nil {
+		return err
+	}
+	if err := g.renderOptions(tmpl, built.options); err != nil {
+		return err
+	}
+
+	for _, client := range built.clients {
 		if err := g.renderClient(tmpl, client); err != nil {
 			return err
 		}
 	}
 
+	if !g.config.SkipPublicAPI {
+		if err := g.renderPublicAPI(built.models, built.options, built.clients); err != nil {
+			return err
+		}
+	}
+
 	rootData := rootTemplateData{
 		Namespace: g.config.Namespace,
-		Clients:   clients,
+		Clients:   built.clients,
 	}
 	if err := g.renderRoot(tmpl, rootData); err != nil {
 		return err
 	}
 	if err := g.renderApiVersion(tmpl, apiVersionTemplateData{
 		Namespace:  g.config.Namespace,
-		ApiVersion: apiVersionFromSpec(doc),
+		ApiVersion: built.apiVersion,
 	}); err != nil {
 		return err
 	}
+	if err := g.renderJSONContext(tmpl, built.models, built.clients); err != nil {
+		return err
+	}
+
+	if g.config.TestingOutputDir != "" {
+		if err := os.MkdirAll(g.config.TestingOutputDir, 0o755); err != nil {
+			return fmt.Errorf("create testing output: %w", err)
+		}
+		if err := cleanGeneratedFiles(g.config.TestingOutputDir); err != nil {
+			return fmt.Errorf("clean testing output: %w", err)
+		}
+		if err := g.renderTesting(tmpl, built.clients); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// reset clears the state accumulated by a previous build so a Generator can
+// be reused across documents.
+func (g *Generator) reset() {
+	g.inlineModels = nil
+	g.modelNames = map[string]struct{}{}
+	g.errorModels = map[string]struct{}{}
+	g.optionNames = map[string]struct{}{}
+	g.patchBuilders = map[string]string{}
+	g.inputVariants = map[string]string{}
+	g.diagnostics = nil
+}
+
 func (g *Generator) renderClient(t *template.Template, client clientTemplateData) (err error) {
 	filePath := filepath.Join(g.config.OutputDir, fmt.Sprintf("%sClient.g.cs", client.ClientName))
 	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
@@ -226,6 +382,8 @@ func (g *Generator) renderModels(t *template.Template, models []modelTemplateDat
 		switch model.Kind {
 		case schemaKindEnum:
 			templateName = "model_enum.tmpl"
+		case schemaKindPatch:
+			templateName = "model_patch.tmpl"
 		default:
 			templateName = "model_class.tmpl"
 		}
@@ -285,7 +443,9 @@ func (g *Generator) renderOptions(t *template.Template, options []optionsTemplat
 }
 
 func (g *Generator) buildModels(doc *v3.Document) ([]modelTemplateData, error) {
+	g.doc = doc
 	g.schemaTypes = map[string]*schemaTypeInfo{}
+	g.partialUpdates = g.partialUpdateSchemas(doc)
 	if doc.Components == nil || doc.Components.Schemas == nil || doc.Components.Schemas.Len() == 0 {
 		return nil, nil
 	}
@@ -296,12 +456,32 @@ func (g *Generator) buildModels(doc *v3.Document) ([]modelTemplateData, error) {
 		schemaProxy := doc.Components.Schemas.GetOrZero(name)
 		g.schemaTypes[name] = &schemaTypeInfo{
 			Name:     name,
-			TypeName: naming.PascalIdentifier(name),
+			TypeName: g.modelName(name),
 			Schema:   schemaProxy,
 		}
 		g.modelNames[g.schemaTypes[name].TypeName] = struct{}{}
 	}
+	names = append(names, g.registerDefs(names)...)
 	sort.Strings(names)
+	// Filtered builds only generate the models their operations use. Unfiltered
+	// builds report the unused ones and skip them under PruneUnreferenced.
+	reachable := g.reachableSchemas(doc)
+	kept := names[:0]
+	for _, name := range names {
+		if _, ok := reachable[name]; ok {
+			kept = append(kept, name)
+			continue
+		}
+		switch {
+		case g.config.Filter.active():
+		case g.config.PruneUnreferenced:
+			g.inform(schemaPointer(name), "unreferenced-schema", "schema is not referenced by any operation and is not generated")
+		default:
+			g.inform(schemaPointer(name), "unreferenced-schema", "schema is not referenced by any operation")
+			kept = append(kept, name)
+		}
+	}
+	names = kept
 
 	for _, name := range names {
 		info := g.schemaTypes[name]
@@ -323,7 +503,9 @@ func (g *Generator) buildModels(doc *v3.Document) ([]modelTemplateData, error) {
 		}
 		// Resolve aliases with inline context so arrays/maps with inline object
 		// values generate concrete models instead of falling back to JsonDocument.
+		restore := g.at(schemaPointer(name))
 		typeInfo, err := g.resolveInlineSchemaType(base.CreateSchemaProxy(schema), true, info.TypeName)
+		restore()
 		if err != nil {
 			return nil, err
 		}
@@ -348,11 +530,15 @@ func (g *Generator) buildModels(doc *v3.Document) ([]modelTemplateData, error) {
 				Kind:            schemaKindEnum,
 				EnumValues:      enumValues,
 				UsesCollections: false,
+				Deprecated:      schemaIsDeprecated(schema),
+				Pointer:         schemaPointer(name),
 			})
 			info.AliasType = info.TypeName
 			info.AliasIsValueType = true
 		case schemaKindObject:
+			restore := g.at(schemaPointer(name))
 			model, err := g.buildClassModel(info.TypeName, schema)
+			restore()
 			if err != nil {
 				return nil, err
 			}
@@ -370,13 +556,46 @@ func (g *Generator) buildModels(doc *v3.Document) ([]modelTemplateData, error) {
 	return models, nil
 }
 
+// registerDefs registers the 3.1 $defs of component schemas so references to
+// them generate models like components do. Definitions are named after
+// themselves unless that collides with another model, in which case the
+// owning component name is prepended. It returns the registered keys.
+func (g *Generator) registerDefs(components []string) []string {
+	var keys []string
+	for _, name := range components {
+		schema := g.schemaFromProxy(g.schemaTypes[name].Schema)
+		if schema == nil || schema.Defs == nil {
+			continue
+		}
+		for defName, proxy := range schema.Defs.FromOldest() {
+			key := name + "/$defs/" + defName
+			typeName, overridden := g.config.ModelNames[key]
+			if !overridden {
+				typeName = naming.PascalIdentifier(defName)
+				if _, taken := g.modelNames[typeName]; taken {
+					typeName = naming.PascalIdentifier(name) + typeName
+				}
+			}
+			g.schemaTypes[key] = &schemaTypeInfo{
+				Name:     key,
+				TypeName: typeName,
+				Schema:   proxy,
+			}
+			g.modelNames[typeName] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
 func (g *Generator) buildEnumValues(schema *base.Schema) []enumValueTemplateData {
-	if schema == nil || len(schema.Enum) == 0 {
+	enum := schemaEnum(schema)
+	if len(enum) == 0 {
 		return nil
 	}
 	names := map[string]int{}
-	values := make([]enumValueTemplateData, 0, len(schema.Enum))
-	for _, raw := range schema.Enum {
+	values := make([]enumValueTemplateData, 0, len(enum))
+	for _, raw := range enum {
 		str := yamlNodeToString(raw)
 		name := naming.PascalIdentifier(str)
 		if count, exists := names[name]; exists {
@@ -394,10 +613,28 @@ func (g *Generator) buildEnumValues(schema *base.Schema) []enumValueTemplateData
 	return values
 }
 
+// requiredMembers makes the required, writable properties of a record
+// required members, so object initializers must set them.
+func requiredMembers(properties []modelPropertyTemplateData) {
+	for i, property := range properties {
+		if property.Required && !property.IsReadOnly {
+			properties[i].IsRequiredMember = true
+			properties[i].NeedsInitializer = false
+		}
+	}
+}
+
 func (g *Generator) buildClassModel(typeName string, schema *base.Schema) (modelTemplateData, error) {
 	props, usesCollections, usesJson, err := g.collectProperties(typeName, schema)
 	if err != nil {
-		return modelTemplateData{}, err
+		return modelTemplateData{}, g.wrap(err)
+	}
+	if g.isPartialUpdate(g.location, schema) {
+		optionalProperties(props)
+	}
+	baseType := ""
+	if info := g.allOfBase(schema); info != nil {
+		baseType = info.TypeName
 	}
 	extensionType := ""
 	dictionaryBaseType := ""
@@ -415,13 +652,19 @@ func (g *Generator) buildClassModel(typeName string, schema *base.Schema) (model
 			usesCollections = true
 		}
 	}
-	isDictionaryModel := len(props) == 0 && extensionType != ""
+	isDictionaryModel := len(props) == 0 && extensionType != "" && baseType == ""
+	isRecord := g.config.RecordModels && !isDictionaryModel
+	if isRecord {
+		requiredMembers(props)
+	}
 	return modelTemplateData{
 		Namespace:              g.config.Namespace,
 		Name:                   typeName,
 		Description:            sanitizeText(schema.Description),
+		BaseType:               baseType,
 		Properties:             props,
 		Kind:                   schemaKindObject,
+		IsRecord:               isRecord,
 		HasProperties:          len(props) > 0,
 		UsesCollections:        usesCollections,
 		UsesJson:               usesJson,
@@ -430,6 +673,8 @@ func (g *Generator) buildClassModel(typeName string, schema *base.Schema) (model
 		IsDictionaryModel:      isDictionaryModel,
 		DictionaryBaseType:     dictionaryBaseType,
 		DictionaryValueType:    extensionType,
+		Deprecated:             schemaIsDeprecated(schema),
+		Pointer:                g.location,
 	}, nil
 }
 
@@ -440,8 +685,17 @@ func (g *Generator) collectProperties(ownerName string, schema *base.Schema) ([]
 	propMap := map[string]modelPropertyTemplateData{}
 	usesCollections := false
 	usesJson := false
+	baseInfo := g.allOfBase(schema)
+	inherited := map[string]*base.SchemaProxy{}
+	if baseInfo != nil {
+		inherited = g.schemaProperties(g.schemaFromProxy(baseInfo.Schema), map[*base.Schema]bool{})
+	} else if parent := g.allOfParent(schema); parent != nil {
+		for _, property := range g.redeclaredProperties(schema, parent) {
+			g.warn(property.pointer, "redeclared-property", "property %q redeclares the one inherited from %s with a different schema; the model is flattened instead of deriving from %s", property.name, parent.TypeName, parent.TypeName)
+		}
+	}
 
-	addProps := func(source *base.Schema) error {
+	addProps := func(source *base.Schema, pointer string) error {
 		if source == nil {
 			return nil
 		}
@@ -465,11 +719,22 @@ func (g *Generator) collectProperties(ownerName string, schema *base.Schema) ([]
 			if _, ok := requiredSet[name]; ok {
 				required = true
 			}
+			propertyPointer := spec.AppendPointer(pointer, "properties", name)
+			if g.skipsProperty(g.schemaFromProxy(propRef)) {
+				continue
+			}
+			if _, ok := inherited[name]; ok {
+				g.warn(propertyPointer, "redeclared-property", "property %q is inherited from %s; the base class declaration is used", name, baseInfo.TypeName)
+				continue
+			}
+			restore := g.at(propertyPointer)
 			typeInfo, err := g.resolvePropertyType(ownerName, name, propRef, required)
+			restore()
 			if err != nil {
 				return err
 			}
 			desc := sanitizeText(g.schemaDescription(propRef))
+			defaultValue, defaultLiteral := g.schemaDefault(g.schemaFromProxy(propRef), typeInfo.TypeName)
 			prop := modelPropertyTemplateData{
 				PropertyName:     naming.PascalIdentifier(name),
 				JsonName:         name,
@@ -480,6 +745,10 @@ func (g *Generator) collectProperties(ownerName string, schema *base.Schema) ([]
 				NeedsInitializer: required && !typeInfo.IsValueType && !strings.HasSuffix(typeInfo.TypeName, "?"),
 				IsValueType:      typeInfo.IsValueType,
 				IsNullable:       strings.HasSuffix(typeInfo.TypeName, "?"),
+				Deprecated:       schemaIsDeprecated(g.schemaFromProxy(propRef)),
+				Default:          defaultValue,
+				DefaultLiteral:   defaultLiteral,
+				Pointer:          propertyPointer,
 			}
 			propMap[name] = prop
 			if typeInfo.IsCollection {
@@ -492,15 +761,22 @@ func (g *Generator) collectProperties(ownerName string, schema *base.Schema) ([]
 		return nil
 	}
 
-	if err := addProps(schema); err != nil {
+	if err := addProps(schema, g.location); err != nil {
 		return nil, false, false, err
 	}
-	for _, allOf := range schema.AllOf {
+	for index, allOf := range schema.AllOf {
+		if index == 0 && baseInfo != nil {
+			continue
+		}
 		sub := g.schemaFromProxy(allOf)
 		if sub == nil {
 			continue
 		}
-		if err := addProps(sub); err != nil {
+		pointer := spec.AppendPointer(g.location, "allOf", strconv.Itoa(index))
+		if allOf.IsReference() {
+			pointer = schemaPointer(componentName(allOf.GetReference()))
+		}
+		if err := addProps(sub, pointer); err != nil {
 			return nil, false, false, err
 		}
 	}
@@ -517,6 +793,103 @@ func (g *Generator) collectProperties(ownerName string, schema *base.Schema) ([]
 	return properties, usesCollections, usesJson, nil
 }
 
+// allOfBase returns the component a model derives from: the allOf parent,
+// unless the other members redeclare one of its properties with a different
+// schema, which a derived class could not represent.
+func (g *Generator) allOfBase(schema *base.Schema) *schemaTypeInfo {
+	info := g.allOfParent(schema)
+	if info == nil || len(g.redeclaredProperties(schema, info)) > 0 {
+		return nil
+	}
+	return info
+}
+
+// allOfParent returns the first allOf member of schema, when it references a
+// generated object model. It returns nil under FlattenAllOf, whose models
+// merge every member instead.
+func (g *Generator) allOfParent(schema *base.Schema) *schemaTypeInfo {
+	if g.config.FlattenAllOf || schema == nil || len(schema.AllOf) == 0 || !schema.AllOf[0].IsReference() {
+		return nil
+	}
+	info, ok := g.schemaTypes[componentName(schema.AllOf[0].GetReference())]
+	if !ok || info.Kind != schemaKindObject || !schemaDefinesStructuredObject(g.schemaFromProxy(info.Schema)) {
+		return nil
+	}
+	// Input flattens bases that are output models.
+	if g.writing && g.splitsReadWrite() && g.hasReadWriteProperties(g.schemaFromProxy(info.Schema), map[*base.Schema]bool{}) {
+		return nil
+	}
+	return info
+}
+
+type redeclaredProperty struct {
+	name    string
+	pointer string
+}
+
+// redeclaredProperties returns the properties the allOf members of schema
+// after the first declare again with a schema other than the one parent
+// declares, in the order collectProperties reads them.
+func (g *Generator) redeclaredProperties(schema *base.Schema, parent *schemaTypeInfo) []redeclaredProperty {
+	inherited := g.schemaProperties(g.schemaFromProxy(parent.Schema), map[*base.Schema]bool{})
+	var redeclared []redeclaredProperty
+	for index, member := range schema.AllOf {
+		sub := g.schemaFromProxy(member)
+		if index == 0 || sub == nil || sub.Properties == nil {
+			continue
+		}
+		pointer := spec.AppendPointer(g.location, "allOf", strconv.Itoa(index))
+		if member.IsReference() {
+			pointer = schemaPointer(componentName(member.GetReference()))
+		}
+		names := make([]string, 0, sub.Properties.Len())
+		for name := range sub.Properties.KeysFromOldest() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			original, ok := inherited[name]
+			if ok && !sameSchema(original, sub.Properties.GetOrZero(name)) {
+				redeclared = append(redeclared, redeclaredProperty{name: name, pointer: spec.AppendPointer(pointer, "properties", name)})
+			}
+		}
+	}
+	return redeclared
+}
+
+// sameSchema reports whether two schemas are identical, documentation
+// included: references to one component, or equal inline schemas.
+func sameSchema(a, b *base.SchemaProxy) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.IsReference() || b.IsReference() {
+		return a.IsReference() && b.IsReference() && a.GetReference() == b.GetReference()
+	}
+	return a.GoLow().Hash() == b.GoLow().Hash()
+}
+
+// schemaProperties returns the properties schema declares, directly or
+// through its allOf members, by name.
+func (g *Generator) schemaProperties(schema *base.Schema, seen map[*base.Schema]bool) map[string]*base.SchemaProxy {
+	properties := map[string]*base.SchemaProxy{}
+	if schema == nil || seen[schema] {
+		return properties
+	}
+	seen[schema] = true
+	for _, member := range schema.AllOf {
+		for name, property := range g.schemaProperties(g.schemaFromProxy(member), seen) {
+			properties[name] = property
+		}
+	}
+	if schema.Properties != nil {
+		for name, property := range schema.Properties.FromOldest() {
+			properties[name] = property
+		}
+	}
+	return properties
+}
+
 func (g *Generator) schemaFromProxy(proxy *base.SchemaProxy) *base.Schema {
 	if proxy == nil {
 		return nil
@@ -547,7 +920,7 @@ func (g *Generator) classifySchema(schema *base.Schema) schemaKind {
 	if schema == nil {
 		return schemaKindAlias
 	}
-	if len(schema.Enum) > 0 {
+	if len(schemaEnum(schema)) > 0 {
 		return schemaKindEnum
 	}
 	if (schema.Properties != nil && schema.Properties.Len() > 0) || len(schema.AllOf) > 0 {
@@ -563,11 +936,12 @@ func (g *Generator) classifySchema(schema *base.Schema) schemaKind {
 }
 
 func (g *Generator) buildClients(doc *v3.Document) ([]clientTemplateData, error) {
+	g.doc = doc
 	clientMap := map[string]*clientTemplateData{}
 	nameCounts := map[string]int{}
 
 	if doc.Paths == nil || doc.Paths.PathItems == nil || doc.Paths.PathItems.Len() == 0 {
-		return nil, fmt.Errorf("spec contains no paths")
+		return nil, g.errorf(spec.Pointer("paths"), "spec contains no paths")
 	}
 
 	for rawPath, pathItem := range doc.Paths.PathItems.FromOldest() {
@@ -582,28 +956,25 @@ func (g *Generator) buildClients(doc *v3.Document) ([]clientTemplateData, error)
 			if operation == nil {
 				continue
 			}
-			tag := "Core"
-			if len(operation.Tags) > 0 {
-				tag = operation.Tags[0]
+			if !g.config.Filter.Matches(rawPath, operation) {
+				continue
 			}
-			clientName := naming.PascalIdentifier(tag)
+			tag := operationTag(operation)
+			clientName := g.clientName(operation)
 			ct, ok := clientMap[clientName]
 			if !ok {
 				ct = &clientTemplateData{
 					Namespace:      g.config.Namespace,
 					ClientName:     clientName,
 					PropertyName:   clientName,
+					Tag:            tag,
 					TagDescription: findTagDescription(doc, tag),
 				}
 				clientMap[clientName] = ct
 			}
 
 			httpMethod := canonicalMethodName(methodName)
-			baseName := g.operationBaseName(httpMethod, rawPath, operation)
-			pascalName := naming.PascalIdentifier(baseName)
-			if pascalName == "" {
-				pascalName = generateOperationName(httpMethod, rawPath)
-			}
+			pascalName := g.methodName(httpMethod, rawPath, operation)
 			key := fmt.Sprintf("%s.%s", clientName, pascalName)
 			count := nameCounts[key]
 			nameCounts[key] = count + 1
@@ -625,6 +996,10 @@ func (g *Generator) buildClients(doc *v3.Document) ([]clientTemplateData, error)
 		}
 	}
 
+	if len(clientMap) == 0 && g.config.Filter.active() {
+		return nil, ErrNoOperations
+	}
+
 	clients := make([]clientTemplateData, 0, len(clientMap))
 	for _, client := range clientMap {
 		sort.Slice(client.Operations, func(i, j int) bool {
@@ -639,6 +1014,8 @@ func (g *Generator) buildClients(doc *v3.Document) ([]clientTemplateData, error)
 }
 
 func (g *Generator) buildOperation(path, method, methodName, clientName string, op *v3.Operation, pathItem *v3.PathItem) (operationTemplateData, error) {
+	operationPointer := spec.Pointer("paths", path, strings.ToLower(method))
+	defer g.at(operationPointer)()
 	parameters := mergeParameters(pathItem.Parameters, op.Parameters)
 	var (
 		pathParams   []parameterTemplateData
@@ -653,9 +1030,13 @@ func (g *Generator) buildOperation(path, method, methodName, clientName string,
 		if param == nil {
 			continue
 		}
+		pointer := parameterPointer(path, method, op, pathItem, param)
+		restore := g.at(pointer)
 		parameter, err := g.convertParameter(param)
+		restore()
+		parameter.Pointer = pointer
 		if err != nil {
-			return operationTemplateData{}, err
+			return operationTemplateData{}, g.wrap(err)
 		}
 		switch parameter.Location {
 		case "path":
@@ -666,7 +1047,7 @@ func (g *Generator) buildOperation(path, method, methodName, clientName string,
 				pathParams = append(pathParams, parameter)
 			}
 		case "query":
-			parameter.OptionsBuilderCall = builderCall(parameter.Location, parameter.Name, "operationOptions."+parameter.PropertyName)
+			parameter.OptionsBuilderCall = builderCall(parameter.Location, parameter.Name, "operationOptions."+parameter.PropertyName, parameter.DefaultLiteral)
 			if idx, ok := queryIndex[parameter.Name]; ok {
 				queryParams[idx] = parameter
 			} else {
@@ -674,31 +1055,37 @@ func (g *Generator) buildOperation(path, method, methodName, clientName string,
 				queryParams = append(queryParams, parameter)
 			}
 		case "header":
-			parameter.OptionsBuilderCall = builderCall(parameter.Location, parameter.Name, "operationOptions."+parameter.PropertyName)
+			parameter.OptionsBuilderCall = builderCall(parameter.Location, parameter.Name, "operationOptions."+parameter.PropertyName, parameter.DefaultLiteral)
 			if idx, ok := headerIndex[parameter.Name]; ok {
 				headerParams[idx] = parameter
 			} else {
 				headerIndex[parameter.Name] = len(headerParams)
 				headerParams = append(headerParams, parameter)
 			}
+		default:
+			g.warn(pointer, "unsupported-parameter", "%s parameter %q is not supported and is omitted from the generated method", param.In, param.Name)
 		}
 	}
 
-	body, err := g.buildRequestBody(clientName, methodName, op.RequestBody)
-	if err != nil {
-		return operationTemplateData{}, err
+	body := g.buildPatchBody(op)
+	if body == nil {
+		var err error
+		body, err = g.buildRequestBody(clientName, methodName, method, op.RequestBody)
+		if err != nil {
+			return operationTemplateData{}, g.wrap(err)
+		}
 	}
 	responseInfo, err := g.resolveResponseType(op, clientName, methodName)
 	if err != nil {
-		return operationTemplateData{}, err
+		return operationTemplateData{}, g.wrap(err)
 	}
 	responseMode, err := g.resolveResponseMode(op, responseInfo)
 	if err != nil {
-		return operationTemplateData{}, err
+		return operationTemplateData{}, g.wrap(err)
 	}
 	errorResponses, err := g.resolveErrorResponses(op, clientName, methodName)
 	if err != nil {
-		return operationTemplateData{}, err
+		return operationTemplateData{}, g.wrap(err)
 	}
 
 	allParams := append([]methodParameter{}, toMethodParameters(pathParams)...)
@@ -758,21 +1145,30 @@ func (g *Generator) buildOperation(path, method, methodName, clientName string,
 		ErrorResponses:      errorResponses,
 		ResponseMode:        responseMode,
 		RequestExamples:     requestExamples(op),
+		Deprecated:          op.Deprecated != nil && *op.Deprecated,
+		Pointer:             operationPointer,
 	}
 	return data, nil
 }
 
 func (g *Generator) convertParameter(param *v3.Parameter) (parameterTemplateData, error) {
 	if param == nil {
-		return parameterTemplateData{}, fmt.Errorf("parameter is nil")
+		return parameterTemplateData{}, g.errorf("", "parameter is nil")
 	}
 	required := param.Required != nil && *param.Required
 	typeInfo := g.resolveType(param.Schema, required)
 	argName := naming.Identifier(param.Name)
 	declaration := ""
+	var schema *base.Schema
+	if param.Schema != nil {
+		schema = param.Schema.Schema()
+	}
+	defaultValue, defaultLiteral := g.schemaDefault(schema, typeInfo.TypeName)
 	if shouldUseOptionalQueryParameter(param, required, typeInfo) {
 		baseType := strings.TrimSuffix(typeInfo.TypeName, "?")
 		declaration = fmt.Sprintf("OptionalQuery<%s> %s = default", baseType, argName)
+		// Unset optional query parameters are omitted rather than defaulted.
+		defaultLiteral = ""
 	} else {
 		defaultValue := ""
 		if !required {
@@ -789,12 +1185,40 @@ func (g *Generator) convertParameter(param *v3.Parameter) (parameterTemplateData
 		Declaration:      declaration,
 		Description:      sanitizeText(param.Description),
 		Required:         required,
-		BuilderCall:      builderCall(param.In, param.Name, argName),
+		BuilderCall:      builderCall(param.In, param.Name, argName, ""),
 		IsCollection:     typeInfo.IsCollection,
 		NeedsInitializer: required && !typeInfo.IsValueType && !strings.HasSuffix(typeInfo.TypeName, "?"),
+		Default:          defaultValue,
+		DefaultLiteral:   defaultLiteral,
+		Example:          parameterExample(param),
 	}, nil
 }
 
+// parameterExample returns the first scalar example documented on the
+// parameter or, failing that, on its schema, including 3.1 examples arrays.
+func parameterExample(param *v3.Parameter) string {
+	nodes := []*yaml.Node{param.Example}
+	if param.Examples != nil {
+		for _, example := range param.Examples.FromOldest() {
+			if example != nil {
+				nodes = append(nodes, example.Value)
+			}
+		}
+	}
+	if param.Schema != nil {
+		if schema := param.Schema.Schema(); schema != nil {
+			nodes = append(nodes, schema.Example)
+			nodes = append(nodes, schema.Examples...)
+		}
+	}
+	for _, node := range nodes {
+		if node != nil && node.Kind == yaml.ScalarNode && node.ShortTag() != "!!null" && node.Value != "" {
+			return node.Value
+		}
+	}
+	return ""
+}
+
 func summaryForOptions(op *v3.Operation, method, path string) string {
 	return sanitizeText(firstNonEmpty(op.Summary, fmt.Sprintf("%s %s", method, path)))
 }
@@ -815,12 +1239,20 @@ func (g *Generator) buildOperationOptions(clientName, methodName, summary string
 
 	appendProps := func(params []parameterTemplateData) {
 		for _, param := range params {
+			// Optional parameters stay unset, so requests only carry the
+			// values callers choose and the server applies its defaults.
+			defaultLiteral := param.DefaultLiteral
+			if strings.HasSuffix(param.TypeName, "?") {
+				defaultLiteral = ""
+			}
 			properties = append(properties, optionsPropertyTemplateData{
 				PropertyName:     param.PropertyName,
 				TypeName:         param.TypeName,
 				Description:      param.Description,
 				Required:         param.Required,
 				NeedsInitializer: param.NeedsInitializer,
+				Default:          param.Default,
+				DefaultLiteral:   defaultLiteral,
 			})
 			usesCollections = usesCollections || param.IsCollection
 			hasRequired = hasRequired || param.Required
@@ -865,20 +1297,21 @@ func schemaAllowsNull(schemaRef *base.SchemaProxy) bool {
 	if schema == nil {
 		return false
 	}
-	if schema.Nullable != nil && *schema.Nullable {
+	if schemaIsNullable(schema) {
 		return true
 	}
 	if len(schema.AllOf) == 1 {
 		return schemaAllowsNull(schema.AllOf[0])
 	}
-	return false
+	return nullableVariant(schema) != nil
 }
 
-func (g *Generator) buildRequestBody(clientName, methodName string, body *v3.RequestBody) (*bodyTemplateData, error) {
+func (g *Generator) buildRequestBody(clientName, methodName, httpMethod string, body *v3.RequestBody) (*bodyTemplateData, error) {
 	if body == nil {
 		return nil, nil
 	}
 
+	defer g.at(spec.AppendPointer(g.location, "requestBody"))()
 	contentType := firstContentType(body.Content)
 	if contentType == "" {
 		return nil, nil
@@ -886,11 +1319,21 @@ func (g *Generator) buildRequestBody(clientName, methodName string, body *v3.Req
 
 	schemaRef := preferredSchema(body.Content)
 	if schemaRef == nil {
+		contentTypes := make([]string, 0, body.Content.Len())
+		for key := range body.Content.KeysFromOldest() {
+			contentTypes = append(contentTypes, key)
+		}
+		if media := body.Content.GetOrZero("application/json"); media != nil {
+			g.warn("", "unsupported-request-body", "application/json request body declares no schema; the operation is generated without a body")
+		} else {
+			g.warn("", "unsupported-request-body", "request body content %s is not supported; the operation is generated without a body", strings.Join(contentTypes, ", "))
+		}
 		return nil, nil
 	}
 
 	required := body.Required != nil && *body.Required
-	typeInfo, err := g.resolveRequestBodyType(schemaRef, required, clientName, methodName)
+	defer g.at(spec.AppendPointer(g.location, "content", preferredContentType(body.Content), "schema"))()
+	typeInfo, err := g.resolveRequestBodyType(schemaRef, required, clientName, methodName, httpMethod)
 	if err != nil {
 		return nil, err
 	}
@@ -910,7 +1353,20 @@ func (g *Generator) buildRequestBody(clientName, methodName string, body *v3.Req
 	}, nil
 }
 
-func (g *Generator) resolveRequestBodyType(schemaRef *base.SchemaProxy, required bool, clientName, methodName string) (typeInfo, error) {
+func (g *Generator) resolveRequestBodyType(schemaRef *base.SchemaProxy, required bool, clientName, methodName, httpMethod string) (typeInfo, error) {
+	if inputSuffix(httpMethod) == inputSuffixUpdate {
+		variant, err := g.updateVariant(schemaRef)
+		if err != nil || variant != "" {
+			return g.nullableType(variant, false, required), err
+		}
+	}
+	if g.splitsReadWrite() {
+		defer g.writingInput()()
+		variant, err := g.inputVariant(schemaRef, inputSuffix(httpMethod))
+		if err != nil || variant != "" {
+			return g.nullableType(variant, false, required), err
+		}
+	}
 	return g.resolveInlineSchemaTypeForUsage(schemaRef, required, fmt.Sprintf("%s%sRequest", clientName, methodName), schemaUsageRequest)
 }
 
@@ -927,12 +1383,25 @@ func (g *Generator) resolveInlineSchemaTypeForUsage(schemaRef *base.SchemaProxy,
 	if schemaRef == nil {
 		return g.nullableType("JsonDocument", false, required), nil
 	}
+	if g.writing {
+		variant, err := g.inputVariant(schemaRef, inputSuffixCreate)
+		if err != nil || variant != "" {
+			return g.nullableType(variant, false, required), err
+		}
+	}
 	if inlineBase != "" && !schemaRef.IsReference() {
 		schema := g.schemaFromProxy(schemaRef)
 		if schema == nil {
 			return g.nullableType("JsonDocument", false, required), nil
 		}
-		if len(schema.Enum) > 0 {
+		if schemaIsNullable(schema) {
+			required = false
+		}
+		if variant := nullableVariant(schema); variant != nil {
+			return g.resolveInlineSchemaTypeForUsage(variant, false, inlineBase, usage)
+		}
+		g.warnIgnoredKeywords(schema)
+		if len(schemaEnum(schema)) > 0 {
 			typeName := g.createInlineEnum(inlineBase, schema)
 			return g.nullableType(typeName, true, required), nil
 		}
@@ -1019,6 +1488,7 @@ func (g *Generator) createInlineEnum(baseName string, schema *base.Schema) strin
 		Kind:            schemaKindEnum,
 		EnumValues:      g.buildEnumValues(schema),
 		UsesCollections: false,
+		Pointer:         g.location,
 	})
 	return typeName
 }
@@ -1053,16 +1523,24 @@ func (g *Generator) resolveResponseType(op *v3.Operation, clientName, methodName
 			continue
 		}
 		resp := op.Responses.Codes.GetOrZero(code)
-		if info, err := g.responseTypeForResponse(resp, fmt.Sprintf("%s%sResponse", clientName, methodName)); err != nil {
+		restore := g.at(spec.AppendPointer(g.location, "responses", code))
+		info, err := g.responseTypeForResponse(resp, fmt.Sprintf("%s%sResponse", clientName, methodName))
+		restore()
+		if err != nil {
 			return typeInfo{}, err
-		} else if info.TypeName != "" {
+		}
+		if info.TypeName != "" {
 			return info, nil
 		}
 	}
 	if resp := op.Responses.Default; resp != nil {
-		if info, err := g.responseTypeForResponse(resp, fmt.Sprintf("%s%sResponseDefault", clientName, methodName)); err != nil {
+		restore := g.at(spec.AppendPointer(g.location, "responses", "default"))
+		info, err := g.responseTypeForResponse(resp, fmt.Sprintf("%s%sResponseDefault", clientName, methodName))
+		restore()
+		if err != nil {
 			return typeInfo{}, err
-		} else if info.TypeName != "" {
+		}
+		if info.TypeName != "" {
 			return info, nil
 		}
 	}
@@ -1124,11 +1602,12 @@ func (g *Generator) errorResponseForCode(resp *v3.Response, code, clientName, me
 	if resp == nil {
 		return nil, nil
 	}
+	defer g.at(spec.AppendPointer(g.location, "responses", code))()
 	statusSuffix := statusCodeSuffix(code)
 	inlineBase := fmt.Sprintf("%s%sError%s", clientName, methodName, statusSuffix)
 	info, err := g.responseTypeForResponse(resp, inlineBase)
 	if err != nil {
-		return nil, err
+		return nil, g.wrap(err)
 	}
 	if info.TypeName == "" {
 		return nil, nil
@@ -1140,6 +1619,7 @@ func (g *Generator) errorResponseForCode(resp *v3.Response, code, clientName, me
 	isDefault := strings.EqualFold(code, "default")
 	statusLiteral := statusCodeLiteral(code)
 	if !isDefault && statusLiteral == "" {
+		g.warn("", "unsupported-status-code", "status code %q is not supported; its error body is not mapped to a typed exception", code)
 		return nil, nil
 	}
 	return &errorResponseTemplateData{
@@ -1193,6 +1673,7 @@ func (g *Generator) responseTypeForResponse(resp *v3.Response, inlineBase string
 	if schemaRef == nil {
 		return typeInfo{}, nil
 	}
+	defer g.at(spec.AppendPointer(g.location, "content", preferredContentType(resp.Content), "schema"))()
 	return g.resolveInlineSchemaTypeForUsage(schemaRef, true, inlineBase, schemaUsageResponse)
 }
 
@@ -1245,7 +1726,65 @@ func (g *Generator) responseModeForResponse(resp *v3.Response, responseInfo type
 	return "json", nil
 }
 
-func (g *Generator) operationBaseName(method, path string, op *v3.Operation) string {
+// ClientName returns the name of the client an operation is generated on,
+// derived from its first tag.
+func ClientName(op *v3.Operation) string {
+	return naming.PascalIdentifier(operationTag(op))
+}
+
+// MethodName returns the C# method name generated for an operation before
+// numeric suffixes are added to resolve collisions within a client.
+func MethodName(method, path string, op *v3.Operation) string {
+	method = canonicalMethodName(method)
+	name := naming.PascalIdentifier(operationBaseName(method, path, op))
+	if name == "" {
+		return generateOperationName(method, path)
+	}
+	return name
+}
+
+// clientName is ClientName with the configured default client and client
+// name overrides applied.
+func (g *Generator) clientName(op *v3.Operation) string {
+	if op == nil || len(op.Tags) == 0 {
+		if g.config.DefaultClient != "" {
+			return g.config.DefaultClient
+		}
+		return ClientName(op)
+	}
+	if name, ok := g.config.ClientNames[op.Tags[0]]; ok {
+		return name
+	}
+	return ClientName(op)
+}
+
+// methodName is MethodName with the configured method name overrides, which
+// take precedence over x-codegen.method_name, applied.
+func (g *Generator) methodName(method, path string, op *v3.Operation) string {
+	if op != nil && op.OperationId != "" {
+		if name, ok := g.config.MethodNames[op.OperationId]; ok {
+			return name
+		}
+	}
+	return MethodName(method, path, op)
+}
+
+// modelName is the type name of a component schema.
+func (g *Generator) modelName(component string) string {
+	if name, ok := g.config.ModelNames[component]; ok {
+		return name
+	}
+	return naming.PascalIdentifier(component)
+}
+
+func operationTag(op *v3.Operation) string {
+	if op != nil && len(op.Tags) > 0 {
+		return op.Tags[0]
+	}
+	return "Core"
+}
+
+func operationBaseName(method, path string, op *v3.Operation) string {
 	if name := methodNameFromExtension(op); name != "" {
 		return name
 	}
@@ -1259,14 +1798,14 @@ func methodNameFromExtension(op *v3.Operation) string {
 	if op == nil || op.Extensions == nil {
 		return ""
 	}
-	node := op.Extensions.GetOrZero("x-codegen")
+	node := op.Extensions.GetOrZero(CodegenExtension)
 	if node == nil || len(node.Content) == 0 {
 		return ""
 	}
 	for i := 0; i+1 < len(node.Content); i += 2 {
 		keyNode := node.Content[i]
 		valueNode := node.Content[i+1]
-		if keyNode != nil && keyNode.Value == "method_name" {
+		if keyNode != nil && keyNode.Value == MethodNameExtension {
 			if valueNode != nil && strings.TrimSpace(valueNode.Value) != "" {
 				return valueNode.Value
 			}
@@ -1282,6 +1821,24 @@ func generateOperationName(method, path string) string {
 	return naming.PascalIdentifier(combined)
 }
 
+// parameterPointer locates a parameter on the operation or, when inherited,
+// on its path item.
+func parameterPointer(path, method string, op *v3.Operation, pathItem *v3.PathItem, param *v3.Parameter) string {
+	for index, candidate := range op.Parameters {
+		if candidate == param {
+			return spec.Pointer("paths", path, strings.ToLower(method), "parameters", strconv.Itoa(index))
+		}
+	}
+	if pathItem != nil {
+		for index, candidate := range pathItem.Parameters {
+			if candidate == param {
+				return spec.Pointer("paths", path, "parameters", strconv.Itoa(index))
+			}
+		}
+	}
+	return spec.Pointer("paths", path, strings.ToLower(method), "parameters")
+}
+
 func mergeParameters(pathParams, opParams []*v3.Parameter) []*v3.Parameter {
 	result := make([]*v3.Parameter, 0, len(pathParams)+len(opParams))
 	result = appendParameters(result, pathParams)
@@ -1299,7 +1856,13 @@ func appendParameters(dst []*v3.Parameter, src []*v3.Parameter) []*v3.Parameter
 	return dst
 }
 
-func builderCall(location, name, arg string) string {
+// builderCall returns the statement adding a parameter to the request. A
+// default literal is passed along so the request builder can omit values
+// equal to the default.
+func builderCall(location, name, arg, defaultLiteral string) string {
+	if defaultLiteral != "" {
+		arg += ", " + defaultLiteral
+	}
 	switch location {
 	case "path":
 		return fmt.Sprintf(`builder.AddPath("%s", %s);`, name, arg)
@@ -1360,12 +1923,19 @@ func (g *Generator) resolveType(schemaRef *base.SchemaProxy, required bool) type
 	if schema == nil {
 		return g.nullableType("JsonDocument", false, required)
 	}
-	if schema.Nullable != nil && *schema.Nullable {
+	if schemaIsNullable(schema) {
 		required = false
 	}
+	if variant := nullableVariant(schema); variant != nil {
+		return g.resolveType(variant, false)
+	}
 	if len(schema.AllOf) == 1 {
 		return g.resolveType(schema.AllOf[0], required)
 	}
+	g.warnIgnoredKeywords(schema)
+	if mapping, ok := g.typeMapping(schema); ok {
+		return g.nullableType(mapping.Type, mapping.ValueType, required)
+	}
 
 	switch {
 	case schemaHasType(schema, "string"):
@@ -1412,6 +1982,14 @@ func (g *Generator) resolveType(schemaRef *base.SchemaProxy, required bool) type
 			typeName := fmt.Sprintf("IEnumerable<%s>", elemName)
 			return g.nullableType(typeName, false, required, true)
 		}
+		if elemName, ok := g.prefixItemsType(schema); ok {
+			return g.nullableType(fmt.Sprintf("IEnumerable<%s>", elemName), false, required, true)
+		}
+		reason := JSONDocumentFallback(schema)
+		if reason == "" {
+			reason = "is a tuple of different types"
+		}
+		g.warn("", "json-document-fallback", "schema %s and is generated as IEnumerable<JsonDocument>", reason)
 		return g.nullableType("IEnumerable<JsonDocument>", false, required, true)
 	case schemaHasType(schema, "object"):
 		if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() && schema.AdditionalProperties.A != nil {
@@ -1428,10 +2006,79 @@ func (g *Generator) resolveType(schemaRef *base.SchemaProxy, required bool) type
 		}
 		return g.nullableType("JsonDocument", false, required)
 	default:
+		if reason := JSONDocumentFallback(schema); reason != "" {
+			g.warn("", "json-document-fallback", "schema %s and is generated as JsonDocument", reason)
+		}
 		return g.nullableType("JsonDocument", false, required)
 	}
 }
 
+// JSONDocumentFallback explains why the generator cannot map schema to a
+// concrete C# type and degrades it to JsonDocument, e.g. "uses oneOf", or
+// returns an empty string when it can. Type mappings are not considered.
+func JSONDocumentFallback(schema *base.Schema) string {
+	if schema == nil || nullableVariant(schema) != nil {
+		return ""
+	}
+	structured := len(schema.Type) > 0 || len(schema.AllOf) > 0 || len(schema.Enum) > 0 ||
+		(schema.Properties != nil && schema.Properties.Len() > 0) || schema.Items != nil || schema.AdditionalProperties != nil
+	switch {
+	case len(schema.OneOf) > 0 && !structured:
+		return "uses oneOf"
+	case len(schema.AnyOf) > 0 && !structured:
+		return "uses anyOf"
+	case !structured && schema.Const == nil:
+		return "declares no type"
+	case schemaHasType(schema, "array") && schema.Items == nil && len(schema.PrefixItems) == 0:
+		return "is an array without items"
+	case schema.Items == nil && !uniformPrefixItems(schema.PrefixItems):
+		return "is a tuple of different types"
+	}
+	return ""
+}
+
+// uniformPrefixItems reports whether the positions of a 3.1 tuple reference
+// the same schema or declare the same type and format.
+func uniformPrefixItems(items []*base.SchemaProxy) bool {
+	key := func(item *base.SchemaProxy) string {
+		if item.IsReference() {
+			return item.GetReference()
+		}
+		schema := item.Schema()
+		if schema == nil {
+			return ""
+		}
+		return strings.Join(schema.Type, "|") + " " + schema.Format
+	}
+	for _, item := range items {
+		if key(item) != key(items[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// typeMapping returns the configured C# type of a primitive schema, preferring
+// a mapping of its format over one of its type.
+func (g *Generator) typeMapping(schema *base.Schema) (TypeMapping, bool) {
+	if len(g.config.TypeMappings) == 0 {
+		return TypeMapping{}, false
+	}
+	for _, openAPIType := range []string{"string", "integer", "number", "boolean"} {
+		if !schemaHasType(schema, openAPIType) {
+			continue
+		}
+		if schema.Format != "" {
+			if mapping, ok := g.config.TypeMappings[openAPIType+"/"+schema.Format]; ok {
+				return mapping, true
+			}
+		}
+		mapping, ok := g.config.TypeMappings[openAPIType]
+		return mapping, ok
+	}
+	return TypeMapping{}, false
+}
+
 func (g *Generator) opaqueObjectType(required bool, usage schemaUsage) typeInfo {
 	switch usage {
 	case schemaUsageResponse:
@@ -1467,16 +2114,25 @@ func firstContentType(content *orderedmap.Map[string, *v3.MediaType]) string {
 }
 
 func preferredSchema(content *orderedmap.Map[string, *v3.MediaType]) *base.SchemaProxy {
-	if content == nil {
+	contentType := preferredContentType(content)
+	if contentType == "" {
 		return nil
 	}
-	if media := content.GetOrZero("application/problem+json"); media != nil && media.Schema != nil {
-		return media.Schema
+	return content.GetOrZero(contentType).Schema
+}
+
+// preferredContentType returns the JSON media type whose schema the SDK uses,
+// or an empty string when none declares a schema.
+func preferredContentType(content *orderedmap.Map[string, *v3.MediaType]) string {
+	if content == nil {
+		return ""
 	}
-	if media := content.GetOrZero("application/json"); media != nil && media.Schema != nil {
-		return media.Schema
+	for _, contentType := range []string{"application/problem+json", "application/json"} {
+		if media := content.GetOrZero(contentType); media != nil && media.Schema != nil {
+			return contentType
+		}
 	}
-	return nil
+	return ""
 }
 
 func firstNonEmpty(values ...string) string {
@@ -1502,11 +2158,7 @@ func sanitizeText(value string) string {
 	value = strings.ReplaceAll(value, "\r\n", " ")
 	value = strings.ReplaceAll(value, "\n", " ")
 	value = strings.Join(strings.Fields(value), " ")
-	return strings.NewReplacer(
-		"&", "&amp;",
-		"<", "&lt;",
-		">", "&gt;",
-	).Replace(value)
+	return xmlEscape(value)
 }
 
 func normalizeDocText(value string) string {
@@ -1533,10 +2185,15 @@ func yamlNodeToString(node *yaml.Node) string {
 	return strings.TrimSpace(string(data))
 }
 
+// schemaHasType reports whether the schema declares the JSON type. Schemas
+// without a type but with a const take the type of the constant.
 func schemaHasType(schema *base.Schema, target string) bool {
 	if schema == nil {
 		return false
 	}
+	if len(schema.Type) == 0 && schema.Const != nil {
+		return constType(schema.Const) == target
+	}
 	for _, t := range schema.Type {
 		if strings.EqualFold(t, target) {
 			return true
@@ -1545,6 +2202,112 @@ func schemaHasType(schema *base.Schema, target string) bool {
 	return false
 }
 
+// schemaIsNullable reports whether the schema admits null, either through the
+// 3.0 nullable keyword or a 3.1 type array such as ["string", "null"].
+func schemaIsNullable(schema *base.Schema) bool {
+	if schema == nil {
+		return false
+	}
+	if schema.Nullable != nil && *schema.Nullable {
+		return true
+	}
+	return len(schema.Type) > 1 && schemaHasType(schema, "null")
+}
+
+// nullableVariant returns the other branch of a two-branch anyOf or oneOf
+// whose second branch is {"type": "null"}, the 3.1 idiom for a nullable $ref.
+func nullableVariant(schema *base.Schema) *base.SchemaProxy {
+	if schema == nil {
+		return nil
+	}
+	for _, branches := range [][]*base.SchemaProxy{schema.AnyOf, schema.OneOf} {
+		if len(branches) != 2 {
+			continue
+		}
+		for index, branch := range branches {
+			if isNullSchema(branch) && !isNullSchema(branches[1-index]) {
+				return branches[1-index]
+			}
+		}
+	}
+	return nil
+}
+
+func isNullSchema(proxy *base.SchemaProxy) bool {
+	if proxy == nil || proxy.IsReference() {
+		return false
+	}
+	schema := proxy.Schema()
+	return schema != nil && len(schema.Type) == 1 && schema.Type[0] == "null"
+}
+
+// schemaEnum returns the allowed values of an enum. A string const is treated
+// as a single-value enum; other constants are plain literals of their type.
+func schemaEnum(schema *base.Schema) []*yaml.Node {
+	if schema == nil {
+		return nil
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum
+	}
+	if schema.Const != nil && constType(schema.Const) == "string" {
+		return []*yaml.Node{schema.Const}
+	}
+	return nil
+}
+
+// constType returns the JSON type of a const value.
+func constType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!int":
+			return "integer"
+		case "!!float":
+			return "number"
+		case "!!bool":
+			return "boolean"
+		case "!!null":
+			return "null"
+		}
+		return "string"
+	}
+	return ""
+}
+
+// prefixItemsType returns the element type of a 3.1 tuple whose positions
+// all resolve to the same C# type, and false for other schemas, which fall
+// back to JsonDocument elements.
+func (g *Generator) prefixItemsType(schema *base.Schema) (string, bool) {
+	if len(schema.PrefixItems) == 0 {
+		return "", false
+	}
+	elemName := ""
+	for _, item := range schema.PrefixItems {
+		name := strings.TrimSuffix(g.resolveType(item, true).TypeName, "?")
+		if elemName != "" && name != elemName {
+			return "", false
+		}
+		elemName = name
+	}
+	return elemName, true
+}
+
+// warnIgnoredKeywords reports 3.1 keywords the generated C# cannot express.
+func (g *Generator) warnIgnoredKeywords(schema *base.Schema) {
+	if schema.If != nil || schema.Then != nil || schema.Else != nil {
+		g.warn("", "ignored-keyword", "if/then/else is ignored; the generated type accepts every branch")
+	}
+}
+
+func schemaIsDeprecated(schema *base.Schema) bool {
+	return schema != nil && schema.Deprecated != nil && *schema.Deprecated
+}
+
 func schemaIsReadOnly(schema *base.Schema) bool {
 	return schema != nil && schema.ReadOnly != nil && *schema.ReadOnly
 }
@@ -1563,10 +2326,20 @@ type typeInfo struct {
 	IsValueType  bool
 }
 
+// modelTemplateData is the data of model_class.tmpl and model_enum.tmpl.
 type modelTemplateData struct {
-	Namespace              string
-	Name                   string
-	Description            string
+	Namespace   string
+	Name        string
+	Description string
+	// BaseType is the model a class derives from; its properties are in
+	// InheritedProperties rather than Properties.
+	BaseType            string
+	InheritedProperties []modelPropertyTemplateData
+	// IsBase reports whether another model derives from this one, which
+	// leaves the class unsealed.
+	IsBase bool
+	// Kind selects the template: 1 for model_class.tmpl, 2 for
+	// model_enum.tmpl, 3 for model_patch.tmpl.
 	Kind                   schemaKind
 	Properties             []modelPropertyTemplateData
 	EnumValues             []enumValueTemplateData
@@ -1579,8 +2352,23 @@ type modelTemplateData struct {
 	DictionaryBaseType     string
 	DictionaryValueType    string
 	EmitToString           bool
-}
-
+	Deprecated             bool
+	// PatchResource is the model a patch builder diffs copies of, and
+	// PatchFormat "merge" or "json".
+	PatchResource string
+	PatchFormat   string
+	// InputOf is the model a request input variant is derived from, without
+	// its readOnly properties, or the model a shared partial update is
+	// derived from.
+	InputOf string
+	// IsRecord reports whether a class model is rendered as a record with
+	// init accessors.
+	IsRecord bool
+	// Pointer is the JSON pointer of the schema in the spec.
+	Pointer string
+}
+
+// modelPropertyTemplateData is a property of a class model.
 type modelPropertyTemplateData struct {
 	PropertyName     string
 	JsonName         string
@@ -1591,17 +2379,32 @@ type modelPropertyTemplateData struct {
 	NeedsInitializer bool
 	IsValueType      bool
 	IsNullable       bool
-}
-
+	IsOptional       bool
+	// IsRequiredMember reports whether a record property has the C#
+	// required modifier, which takes the place of = default!.
+	IsRequiredMember bool
+	Deprecated       bool
+	// Default is the schema default as written in the spec and
+	// DefaultLiteral the C# literal initializing the property to it; empty
+	// when the type has no literal.
+	Default        string
+	DefaultLiteral string
+	Pointer        string
+}
+
+// enumValueTemplateData is a member of an enum model.
 type enumValueTemplateData struct {
 	Name  string
 	Value string
 }
 
+// clientTemplateData is the data of client.tmpl, one per tag.
 type clientTemplateData struct {
-	Namespace          string
-	ClientName         string
-	PropertyName       string
+	Namespace    string
+	ClientName   string
+	PropertyName string
+	// Tag is the tag of the operations, "Core" for untagged operations.
+	Tag                string
 	TagDescription     string
 	Operations         []operationTemplateData
 	UsesCollections    bool
@@ -1609,6 +2412,7 @@ type clientTemplateData struct {
 	UsesErrorResponses bool
 }
 
+// operationTemplateData is a client method.
 type operationTemplateData struct {
 	OperationID         string
 	MethodName          string
@@ -1634,16 +2438,24 @@ type operationTemplateData struct {
 	UsesCollections     bool
 	HasErrorResponses   bool
 	ErrorResponses      []errorResponseTemplateData
-	ResponseMode        string
-	RequestExamples     []requestExample
+	// ResponseMode is how the response is read: "json", "json-document",
+	// "string" or "none".
+	ResponseMode string
+	// RequestExamples feed code samples and are not part of the template
+	// data contract.
+	RequestExamples []requestExample
+	Deprecated      bool
+	Pointer         string
 }
 
+// errorResponseTemplateData maps a documented error status to its type.
 type errorResponseTemplateData struct {
 	StatusCodeLiteral string
 	ErrorType         string
 	IsDefault         bool
 }
 
+// parameterTemplateData is a path, query or header parameter.
 type parameterTemplateData struct {
 	Location           string
 	Name               string
@@ -1657,14 +2469,23 @@ type parameterTemplateData struct {
 	OptionsBuilderCall string
 	IsCollection       bool
 	NeedsInitializer   bool
+	// Default and DefaultLiteral are the schema default and its C# literal,
+	// as on model properties.
+	Default        string
+	DefaultLiteral string
+	// Example is the first documented scalar example, used by code samples.
+	Example string
+	Pointer string
 }
 
+// methodParameter is a parameter of a client method signature.
 type methodParameter struct {
 	Name        string
 	Signature   string
 	Description string
 }
 
+// bodyTemplateData is the request body of an operation.
 type bodyTemplateData struct {
 	ArgName      string
 	Signature    string
@@ -1673,13 +2494,17 @@ type bodyTemplateData struct {
 	ContentType  string
 	TypeName     string
 	IsCollection bool
+	// PatchResource is the model a patch builder body diffs copies of.
+	PatchResource string
 }
 
+// rootTemplateData is the data of root_client.tmpl.
 type rootTemplateData struct {
 	Namespace string
 	Clients   []clientTemplateData
 }
 
+// optionsTemplateData is the data of operation_options.tmpl.
 type optionsTemplateData struct {
 	Namespace       string
 	Name            string
@@ -1691,12 +2516,15 @@ type optionsTemplateData struct {
 	Signature       string
 }
 
+// optionsPropertyTemplateData is a property of an options class.
 type optionsPropertyTemplateData struct {
 	PropertyName     string
 	TypeName         string
 	Description      string
 	Required         bool
 	NeedsInitializer bool
+	Default          string
+	DefaultLiteral   string
 }
 
 func findTagDescription(doc *v3.Document, tagName string) string {
@@ -1714,6 +2542,7 @@ func findTagDescription(doc *v3.Document, tagName string) string {
 	return ""
 }
 
+// apiVersionTemplateData is the data of api_version.tmpl.
 type apiVersionTemplateData struct {
 	Namespace  string
 	ApiVersion string
@@ -1765,13 +2594,13 @@ func hasCollections(params []parameterTemplateData) bool {
 	return false
 }
 
-func (g *Generator) cleanOutputDir() error {
-	return filepath.WalkDir(g.config.OutputDir, func(path string, d fs.DirEntry, err error) error {
+func cleanGeneratedFiles(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 		if d.IsDir() {
-			if path == g.config.OutputDir {
+			if path == dir {
 				return nil
 			}
 			name := d.Name()
@@ -1780,7 +2609,7 @@ func (g *Generator) cleanOutputDir() error {
 			}
 			return nil
 		}
-		if strings.HasSuffix(d.Name(), ".g.cs") {
+		if strings.HasSuffix(d.Name(), ".g.cs") || d.Name() == publicAPIFile {
 			if err := os.Remove(path); err != nil {
 				return err
 			}
@@ -1789,10 +2618,20 @@ func (g *Generator) cleanOutputDir() error {
 	})
 }
 
+// schemaPointer returns the JSON pointer of a schemaTypes key.
+func schemaPointer(name string) string {
+	return spec.Pointer(append([]string{"components", "schemas"}, strings.Split(name, "/")...)...)
+}
+
+// componentName returns the schemaTypes key a reference resolves to: the
+// component name, or "Component/$defs/Name" for a definition nested in one.
 func componentName(ref string) string {
 	if ref == "" {
 		return ""
 	}
+	if owner, def, ok := strings.Cut(ref, "/$defs/"); ok {
+		return componentName(owner) + "/$defs/" + def
+	}
 	segments := strings.Split(ref, "/")
 	return segments[len(segments)-1]
 }
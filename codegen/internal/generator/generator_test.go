@@ -1,10 +1,16 @@
 package generator
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/pb33f/libopenapi"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
 )
 
 func TestBuildModels_GeneratesInlineEnumForLinksRelation(t *testing.T) {
@@ -391,6 +397,683 @@ func TestBuildClients_UsesOperationOptionsForQueryParameters(t *testing.T) {
 	}
 }
 
+func TestBuild_AppliesSchemaDefaults(t *testing.T) {
+	const spec = `{
+	  "openapi": "3.0.3",
+	  "info": { "title": "test", "version": "1.0.0" },
+	  "paths": {
+	    "/v0.1/readers": {
+	      "get": {
+	        "tags": ["Readers"],
+	        "operationId": "ListReaders",
+	        "parameters": [
+	          { "name": "limit", "in": "query", "schema": { "type": "integer", "default": 10 } },
+	          { "name": "order", "in": "query", "schema": { "type": "string", "enum": ["asc", "desc"], "default": "asc" } },
+	          { "name": "since", "in": "query", "schema": { "type": "string", "format": "date-time", "default": "2020-01-01T00:00:00Z" } }
+	        ],
+	        "responses": { "200": { "description": "ok" } }
+	      },
+	      "patch": {
+	        "tags": ["Readers"],
+	        "operationId": "UpdateReader",
+	        "requestBody": {
+	          "content": { "application/json": { "schema": { "$ref": "#/components/schemas/ReaderUpdate" } } }
+	        },
+	        "responses": { "200": { "description": "ok", "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Reader" } } } } }
+	      },
+	      "post": {
+	        "tags": ["Terminals"],
+	        "operationId": "CreateReaderCheckout",
+	        "requestBody": {
+	          "content": { "application/json": { "schema": { "$ref": "#/components/schemas/ReaderCheckout" } } }
+	        },
+	        "responses": { "201": { "description": "created" } }
+	      }
+	    }
+	  },
+	  "components": {
+	    "schemas": {
+	      "ReaderCheckout": {
+	        "type": "object",
+	        "properties": {
+	          "tip_timeout": { "type": "integer", "default": 30 },
+	          "tips": { "type": "array", "items": { "$ref": "#/components/schemas/Tip" } }
+	        }
+	      },
+	      "Tip": {
+	        "type": "object",
+	        "properties": { "rate": { "type": "number", "format": "double", "default": 0.1 } }
+	      },
+	      "Reader": {
+	        "type": "object",
+	        "properties": {
+	          "status": { "type": "string", "enum": ["paired", "expired"], "default": "paired" },
+	          "active": { "type": "boolean", "default": true },
+	          "tip_rate": { "type": "number", "format": "float", "default": 0.1 },
+	          "name": { "type": "string", "default": "Front \"desk\"" }
+	        }
+	      },
+	      "ReaderUpdate": {
+	        "type": "object",
+	        "properties": { "timeout": { "type": "integer", "default": 30 } }
+	      }
+	    }
+	  }
+	}`
+
+	g := New(Config{Namespace: "SumUp"})
+	built, err := g.build(mustBuildV3Document(t, spec))
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+
+	options := built.clients[0].Operations[0].OperationOptions
+	literals := map[string]string{}
+	for _, property := range options.Properties {
+		literals[property.PropertyName] = property.DefaultLiteral
+	}
+	for name, literal := range literals {
+		if literal != "" {
+			t.Errorf("options %s is initialized to %s, want it unset", name, literal)
+		}
+	}
+	if options.Properties[2].Default != "2020-01-01T00:00:00Z" {
+		t.Errorf("Since default = %q, want it documented", options.Properties[2].Default)
+	}
+	calls := []string{}
+	for _, parameter := range built.clients[0].Operations[0].QueryParams {
+		calls = append(calls, parameter.OptionsBuilderCall)
+	}
+	wantCalls := []string{
+		`builder.AddQuery("limit", operationOptions.Limit, 10);`,
+		`builder.AddQuery("order", operationOptions.Order, "asc");`,
+		`builder.AddQuery("since", operationOptions.Since);`,
+	}
+	if !reflect.DeepEqual(calls, wantCalls) {
+		t.Errorf("builder calls = %q, want %q", calls, wantCalls)
+	}
+
+	models := map[string]modelTemplateData{}
+	for _, model := range built.models {
+		models[model.Name] = model
+	}
+	literals = map[string]string{}
+	for _, property := range models["Reader"].Properties {
+		literals[property.PropertyName] = property.DefaultLiteral
+	}
+	want := map[string]string{"Status": "ReaderStatus.Paired", "Active": "true", "TipRate": "0.1f", "Name": `"Front \"desk\""`}
+	for name, literal := range want {
+		if literals[name] != literal {
+			t.Errorf("Reader.%s default literal = %q, want %q", name, literals[name], literal)
+		}
+	}
+	timeout := models["ReaderUpdate"].Properties[0]
+	if !timeout.IsOptional || timeout.DefaultLiteral != "" || timeout.Default != "30" {
+		t.Errorf("partial update property = %+v, want a documented default without initializer", timeout)
+	}
+	for _, name := range []string{"ReaderCheckout", "Tip"} {
+		for _, property := range models[name].Properties {
+			if property.DefaultLiteral != "" {
+				t.Errorf("request body property %s.%s is initialized to %s, want only its default documented", name, property.PropertyName, property.DefaultLiteral)
+			}
+		}
+	}
+	if tipTimeout := models["ReaderCheckout"].Properties[0]; tipTimeout.Default != "30" {
+		t.Errorf("ReaderCheckout.TipTimeout default = %q, want it documented", tipTimeout.Default)
+	}
+}
+
+const allOfSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {},
+  "components": {
+    "schemas": {
+      "Transaction": {
+        "type": "object",
+        "required": ["id"],
+        "additionalProperties": true,
+        "properties": {
+          "id": { "type": "string" },
+          "amount": { "type": "number" }
+        }
+      },
+      "TransactionFull": {
+        "allOf": [
+          { "$ref": "#/components/schemas/Transaction" },
+          {
+            "type": "object",
+            "properties": {
+              "amount": { "type": "number" },
+              "card": { "type": "string" }
+            }
+          }
+        ]
+      },
+      "TransactionRefund": {
+        "allOf": [
+          { "$ref": "#/components/schemas/TransactionFull" },
+          { "type": "object", "properties": { "refunded_at": { "type": "string" } } }
+        ]
+      }
+    }
+  }
+}`
+
+func TestBuild_DerivesClassesFromLeadingAllOfReference(t *testing.T) {
+	doc := mustBuildV3Document(t, allOfSpec)
+
+	g := New(Config{Namespace: "SumUp"})
+	models, err := g.buildModels(doc)
+	if err != nil {
+		t.Fatalf("buildModels() error = %v", err)
+	}
+	linkBaseTypes(models)
+
+	transaction := findModel(t, models, "Transaction")
+	if !transaction.IsBase || transaction.BaseType != "" || !transaction.HasExtensionData {
+		t.Fatalf("Transaction = %+v, want an unsealed root class keeping additional properties", transaction)
+	}
+	full := findModel(t, models, "TransactionFull")
+	if full.BaseType != "Transaction" || !full.IsBase || full.HasExtensionData {
+		t.Fatalf("TransactionFull = %+v, want a base class deriving from Transaction", full)
+	}
+	if len(full.Properties) != 1 || full.Properties[0].PropertyName != "Card" {
+		t.Errorf("TransactionFull properties = %+v, want its own Card only", full.Properties)
+	}
+	refund := findModel(t, models, "TransactionRefund")
+	if refund.BaseType != "TransactionFull" || refund.IsBase || refund.HasExtensionData {
+		t.Fatalf("TransactionRefund = %+v, want a sealed class deriving from TransactionFull", refund)
+	}
+	var inherited []string
+	for _, property := range refund.InheritedProperties {
+		inherited = append(inherited, property.JsonName)
+	}
+	if got := strings.Join(inherited, ","); got != "amount,card,id" {
+		t.Errorf("TransactionRefund inherited properties = %s, want amount,card,id", got)
+	}
+
+	var redeclared []string
+	for _, diagnostic := range g.Diagnostics() {
+		if diagnostic.Code == "redeclared-property" && diagnostic.Severity == diag.Warning {
+			redeclared = append(redeclared, diagnostic.Pointer)
+		}
+	}
+	if len(redeclared) != 1 || redeclared[0] != "#/components/schemas/TransactionFull/allOf/1/properties/amount" {
+		t.Errorf("redeclared-property warnings = %v, want the amount property of TransactionFull", redeclared)
+	}
+}
+
+func TestBuild_FlattensModelsRedeclaringInheritedPropertiesDifferently(t *testing.T) {
+	doc := mustBuildV3Document(t, strings.Replace(allOfSpec, `"amount": { "type": "number" },
+              "card"`, `"amount": { "type": "integer" },
+              "card"`, 1))
+
+	g := New(Config{Namespace: "SumUp"})
+	models, err := g.buildModels(doc)
+	if err != nil {
+		t.Fatalf("buildModels() error = %v", err)
+	}
+	linkBaseTypes(models)
+
+	full := findModel(t, models, "TransactionFull")
+	if full.BaseType != "" || !full.IsBase {
+		t.Fatalf("TransactionFull = %+v, want a standalone base class", full)
+	}
+	if got := propertyType(full.Properties, "Amount"); got != "int?" {
+		t.Errorf("TransactionFull.Amount type = %q, want the redeclared int?", got)
+	}
+	if got := propertyType(full.Properties, "Id"); got != "string" {
+		t.Errorf("TransactionFull.Id type = %q, want the flattened required string", got)
+	}
+	if refund := findModel(t, models, "TransactionRefund"); refund.BaseType != "TransactionFull" {
+		t.Errorf("TransactionRefund base type = %q, want TransactionFull", refund.BaseType)
+	}
+
+	var redeclared []string
+	for _, diagnostic := range g.Diagnostics() {
+		if diagnostic.Code == "redeclared-property" && diagnostic.Severity == diag.Warning {
+			redeclared = append(redeclared, diagnostic.Pointer)
+		}
+	}
+	if len(redeclared) != 1 || redeclared[0] != "#/components/schemas/TransactionFull/allOf/1/properties/amount" {
+		t.Errorf("redeclared-property warnings = %v, want the amount property of TransactionFull", redeclared)
+	}
+}
+
+func TestBuild_FlattensAllOfWhenInheritanceIsDisabled(t *testing.T) {
+	doc := mustBuildV3Document(t, allOfSpec)
+
+	models, err := New(Config{Namespace: "SumUp", FlattenAllOf: true}).buildModels(doc)
+	if err != nil {
+		t.Fatalf("buildModels() error = %v", err)
+	}
+	linkBaseTypes(models)
+	for _, model := range models {
+		if model.BaseType != "" || model.IsBase || len(model.InheritedProperties) > 0 {
+			t.Fatalf("%s = %+v, want a standalone class", model.Name, model)
+		}
+	}
+	full := findModel(t, models, "TransactionFull")
+	if got := propertyType(full.Properties, "Id"); got != "string" {
+		t.Errorf("TransactionFull.Id type = %q, want the flattened required string", got)
+	}
+}
+
+func TestBuild_UsesOptionalForPartialUpdates(t *testing.T) {
+	const spec = `{
+	  "openapi": "3.0.3",
+	  "info": { "title": "test", "version": "1.0.0" },
+	  "paths": {
+	    "/v0.1/readers/{id}": {
+	      "patch": {
+	        "tags": ["Readers"],
+	        "operationId": "UpdateReader",
+	        "requestBody": { "content": { "application/json": { "schema": {
+	          "type": "object",
+	          "properties": { "name": { "type": "string" } }
+	        } } } },
+	        "responses": { "204": { "description": "ok" } }
+	      },
+	      "put": {
+	        "tags": ["Readers"],
+	        "operationId": "ReplaceReader",
+	        "requestBody": { "content": { "application/json": { "schema": { "$ref": "#/components/schemas/ReaderSettings" } } } },
+	        "responses": { "204": { "description": "ok" } }
+	      }
+	    },
+	    "/v0.1/checkouts/{id}": {
+	      "patch": {
+	        "tags": ["Checkouts"],
+	        "operationId": "UpdateCheckout",
+	        "requestBody": { "content": { "application/json": { "schema": { "$ref": "#/components/schemas/CheckoutUpdate" } } } },
+	        "responses": { "204": { "description": "ok" } }
+	      },
+	      "post": {
+	        "tags": ["Checkouts"],
+	        "operationId": "ProcessCheckout",
+	        "requestBody": { "content": { "application/json": { "schema": { "$ref": "#/components/schemas/CheckoutProcess" } } } },
+	        "responses": { "204": { "description": "ok" } }
+	      }
+	    }
+	  },
+	  "components": {
+	    "schemas": {
+	      "CheckoutUpdate": {
+	        "type": "object",
+	        "required": ["reference"],
+	        "properties": {
+	          "reference": { "type": "string" },
+	          "amount": { "type": "number" },
+	          "id": { "type": "string", "readOnly": true }
+	        }
+	      },
+	      "CheckoutProcess": {
+	        "type": "object",
+	        "properties": { "token": { "type": "string" } }
+	      },
+	      "ReaderSettings": {
+	        "type": "object",
+	        "x-codegen": { "partial_update": true },
+	        "properties": { "tags": { "type": "array", "items": { "type": "string" } } }
+	      }
+	    }
+	  }
+	}`
+	doc := mustBuildV3Document(t, spec)
+
+	// Shared models keep the readOnly property in the PATCH body.
+	built, err := New(Config{Namespace: "SumUp"}).build(doc)
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+
+	update := findModel(t, built.models, "CheckoutUpdate")
+	if got := propertyType(update.Properties, "Amount"); got != "Optional<decimal?>" {
+		t.Errorf("CheckoutUpdate.Amount type = %q, want Optional<decimal?>", got)
+	}
+	if got := propertyType(update.Properties, "Reference"); got != "string" {
+		t.Errorf("required CheckoutUpdate.Reference type = %q, want string", got)
+	}
+	if got := propertyType(update.Properties, "Id"); got != "string?" {
+		t.Errorf("read-only CheckoutUpdate.Id type = %q, want string?", got)
+	}
+	for _, property := range update.Properties {
+		if property.IsOptional != strings.HasPrefix(property.TypeName, "Optional<") || property.IsOptional && (property.IsNullable || property.NeedsInitializer) {
+			t.Errorf("CheckoutUpdate.%s = %+v", property.PropertyName, property)
+		}
+	}
+	if got := propertyType(findModel(t, built.models, "ReadersUpdateReaderRequest").Properties, "Name"); got != "Optional<string?>" {
+		t.Errorf("inline PATCH body Name type = %q, want Optional<string?>", got)
+	}
+	if got := propertyType(findModel(t, built.models, "ReaderSettings").Properties, "Tags"); got != "Optional<IEnumerable<string>?>" {
+		t.Errorf("ReaderSettings.Tags type = %q, want the x-codegen partial update to use Optional", got)
+	}
+	if got := propertyType(findModel(t, built.models, "CheckoutProcess").Properties, "Token"); got != "string?" {
+		t.Errorf("POST body CheckoutProcess.Token type = %q, want string?", got)
+	}
+}
+
+const readWriteSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {
+    "/v0.1/customers": {
+      "post": {
+        "tags": ["Customers"],
+        "operationId": "CreateCustomer",
+        "requestBody": { "required": true, "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Customer" } } } },
+        "responses": { "201": { "description": "ok", "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Customer" } } } } }
+      }
+    },
+    "/v0.1/customers/{id}": {
+      "patch": {
+        "tags": ["Customers"],
+        "operationId": "UpdateCustomer",
+        "requestBody": { "required": true, "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Customer" } } } },
+        "responses": { "204": { "description": "ok" } }
+      }
+    },
+    "/v0.1/notes": {
+      "post": {
+        "tags": ["Notes"],
+        "operationId": "CreateNote",
+        "requestBody": { "required": true, "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Note" } } } },
+        "responses": { "204": { "description": "ok" } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Customer": {
+        "type": "object",
+        "required": ["name"],
+        "properties": {
+          "id": { "type": "string", "readOnly": true },
+          "name": { "type": "string" },
+          "password": { "type": "string", "writeOnly": true },
+          "address": { "$ref": "#/components/schemas/Address" }
+        }
+      },
+      "Address": {
+        "type": "object",
+        "properties": {
+          "line1": { "type": "string" },
+          "verified_at": { "type": "string", "format": "date-time", "readOnly": true }
+        }
+      },
+      "Note": {
+        "type": "object",
+        "properties": { "text": { "type": "string" } }
+      }
+    }
+  }
+}`
+
+func TestBuild_SplitsModelsByReadOnlyAndWriteOnly(t *testing.T) {
+	g := New(Config{Namespace: "SumUp", ReadWriteModels: true})
+	built, err := g.build(mustBuildV3Document(t, readWriteSpec))
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+
+	bodies := map[string]string{}
+	responses := map[string]string{}
+	for _, client := range built.clients {
+		for _, operation := range client.Operations {
+			bodies[operation.OperationID] = operation.Body.TypeName
+			responses[operation.OperationID] = operation.ResponseType
+		}
+	}
+	want := map[string]string{"CreateCustomer": "CustomerCreate", "UpdateCustomer": "CustomerUpdate", "CreateNote": "Note"}
+	if !reflect.DeepEqual(bodies, want) {
+		t.Errorf("body types = %v, want %v", bodies, want)
+	}
+	if responses["CreateCustomer"] != "Customer" {
+		t.Errorf("CreateCustomer response type = %q, want Customer", responses["CreateCustomer"])
+	}
+
+	names := func(model modelTemplateData) []string {
+		names := []string{}
+		for _, property := range model.Properties {
+			names = append(names, property.PropertyName+":"+property.TypeName)
+		}
+		return names
+	}
+	tests := []struct {
+		model   string
+		inputOf string
+		want    []string
+	}{
+		{"Customer", "", []string{"Address:Address?", "Id:string?", "Name:string"}},
+		{"CustomerCreate", "Customer", []string{"Address:AddressCreate?", "Name:string", "Password:string?"}},
+		{"CustomerUpdate", "Customer", []string{"Address:Optional<AddressCreate?>", "Name:string", "Password:Optional<string?>"}},
+		{"AddressCreate", "Address", []string{"Line1:string?"}},
+	}
+	for _, tt := range tests {
+		model := findModel(t, built.models, tt.model)
+		if got := names(model); !reflect.DeepEqual(got, tt.want) || model.InputOf != tt.inputOf {
+			t.Errorf("%s properties = %v, input of %q; want %v, input of %q", tt.model, got, model.InputOf, tt.want, tt.inputOf)
+		}
+	}
+}
+
+func TestBuild_SharesModelsByDefault(t *testing.T) {
+	g := New(Config{Namespace: "SumUp"})
+	built, err := g.build(mustBuildV3Document(t, readWriteSpec))
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+
+	bodies := map[string]string{}
+	for _, client := range built.clients {
+		for _, operation := range client.Operations {
+			bodies[operation.OperationID] = operation.Body.TypeName
+		}
+	}
+	want := map[string]string{"CreateCustomer": "Customer", "UpdateCustomer": "CustomerUpdate", "CreateNote": "Note"}
+	if !reflect.DeepEqual(bodies, want) {
+		t.Errorf("body types = %v, want %v", bodies, want)
+	}
+	for _, model := range built.models {
+		if model.InputOf != "" && model.Name != "CustomerUpdate" {
+			t.Errorf("unexpected input variant %s", model.Name)
+		}
+	}
+	customer := findModel(t, built.models, "Customer")
+	if got := propertyType(customer.Properties, "Password"); got != "string?" {
+		t.Errorf("Customer.Password type = %q, want string?", got)
+	}
+	update := findModel(t, built.models, "CustomerUpdate")
+	if got := propertyType(update.Properties, "Password"); got != "Optional<string?>" || update.InputOf != "Customer" {
+		t.Errorf("CustomerUpdate.Password type = %q, input of %q; want the partial update of Customer", got, update.InputOf)
+	}
+
+	var shared []string
+	for _, diagnostic := range g.Diagnostics() {
+		if diagnostic.Code == "shared-partial-update" {
+			shared = append(shared, diagnostic.Pointer)
+		}
+	}
+	if len(shared) != 1 || shared[0] != "#/paths/~1v0.1~1customers~1{id}/patch/requestBody/content/application~1json/schema" {
+		t.Errorf("shared-partial-update diagnostics = %v, want the body of UpdateCustomer", shared)
+	}
+}
+
+func TestRun_RendersRecordModels(t *testing.T) {
+	doc := mustBuildV3Document(t, readWriteSpec)
+	output := t.TempDir()
+	if err := New(Config{OutputDir: output, Namespace: "SumUp", ReadWriteModels: true, RecordModels: true, SkipPublicAPI: true}).Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(output, "Models", "CustomerCreate.g.cs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"public sealed partial record CustomerCreate",
+		"public required string Name { get; init; }",
+		"public string? Password { get; init; }",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("CustomerCreate.g.cs does not contain %q:\n%s", want, content)
+		}
+	}
+}
+
+const patchSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {
+    "/v0.1/readers/{id}": {
+      "patch": {
+        "tags": ["Readers"],
+        "operationId": "UpdateReader",
+        "requestBody": { "required": true, "content": { "application/merge-patch+json": { "schema": { "$ref": "#/components/schemas/Reader" } } } },
+        "responses": { "200": { "description": "ok", "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Reader" } } } } }
+      }
+    },
+    "/v0.1/customers/{id}": {
+      "patch": {
+        "tags": ["Customers"],
+        "operationId": "UpdateCustomer",
+        "requestBody": { "content": { "application/json-patch+json": { "schema": { "type": "array", "items": { "type": "object" } } } } },
+        "responses": { "200": { "description": "ok", "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Customer" } } } } }
+      },
+      "put": {
+        "tags": ["Customers"],
+        "operationId": "ReplaceCustomer",
+        "x-codegen": { "patch_builder": "merge" },
+        "requestBody": { "required": true, "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Customer" } } } },
+        "responses": { "204": { "description": "ok" } }
+      },
+      "post": {
+        "tags": ["Customers"],
+        "operationId": "TouchCustomer",
+        "x-codegen": { "patch_builder": "strategic" },
+        "requestBody": { "content": { "application/json": { "schema": { "$ref": "#/components/schemas/Customer" } } } },
+        "responses": { "204": { "description": "ok" } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Reader": { "type": "object", "properties": { "name": { "type": "string" } } },
+      "Customer": { "type": "object", "properties": { "email": { "type": "string" } } }
+    }
+  }
+}`
+
+func TestBuild_UsesPatchBuildersForPatchDocuments(t *testing.T) {
+	doc := mustBuildV3Document(t, patchSpec)
+
+	g := New(Config{Namespace: "SumUp"})
+	built, err := g.build(doc)
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+	bodies := map[string]*bodyTemplateData{}
+	for _, client := range built.clients {
+		for _, operation := range client.Operations {
+			bodies[operation.OperationID] = operation.Body
+		}
+	}
+
+	tests := []struct {
+		operation, typeName, contentType, resource string
+	}{
+		{"UpdateReader", "ReaderPatch", "application/merge-patch+json", "Reader"},
+		{"UpdateCustomer", "CustomerJsonPatch", "application/json-patch+json", "Customer"},
+		{"ReplaceCustomer", "CustomerPatch", "application/json", "Customer"},
+		{"TouchCustomer", "Customer?", "application/json", ""},
+	}
+	for _, test := range tests {
+		body := bodies[test.operation]
+		if body == nil || body.TypeName != test.typeName || body.ContentType != test.contentType || body.PatchResource != test.resource {
+			t.Errorf("%s body = %+v, want %s sent as %s", test.operation, body, test.typeName, test.contentType)
+		}
+	}
+	if got := bodies["UpdateCustomer"].Signature; got != "CustomerJsonPatch? body = null" {
+		t.Errorf("optional patch body signature = %q", got)
+	}
+
+	readerPatch := findModel(t, built.models, "ReaderPatch")
+	if readerPatch.Kind != schemaKindPatch || readerPatch.BaseType != "JsonMergePatch" || readerPatch.PatchResource != "Reader" || readerPatch.PatchFormat != patchFormatMerge {
+		t.Errorf("ReaderPatch = %+v", readerPatch)
+	}
+	if customerPatch := findModel(t, built.models, "CustomerJsonPatch"); customerPatch.BaseType != "JsonPatch" || customerPatch.PatchFormat != patchFormatJSON {
+		t.Errorf("CustomerJsonPatch = %+v", customerPatch)
+	}
+
+	var unsupported []string
+	for _, diagnostic := range g.Diagnostics() {
+		if diagnostic.Code == "unsupported-patch-builder" {
+			unsupported = append(unsupported, diagnostic.Pointer)
+		}
+	}
+	if len(unsupported) != 1 || unsupported[0] != "#/paths/~1v0.1~1customers~1{id}/post/requestBody" {
+		t.Errorf("unsupported-patch-builder diagnostics = %v, want the unknown format of TouchCustomer", unsupported)
+	}
+}
+
+func TestRun_RendersPatchBuilders(t *testing.T) {
+	doc := mustBuildV3Document(t, patchSpec)
+	output := t.TempDir()
+	if err := New(Config{OutputDir: output, Namespace: "SumUp"}).Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for file, want := range map[string]string{
+		"ReaderPatch.g.cs":       "public sealed partial class ReaderPatch : JsonMergePatch",
+		"CustomerJsonPatch.g.cs": "public static CustomerJsonPatch From(Customer original, Customer modified)",
+	} {
+		content, err := os.ReadFile(filepath.Join(output, "Models", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), want) {
+			t.Errorf("%s does not contain %q:\n%s", file, want, content)
+		}
+	}
+	client, err := os.ReadFile(filepath.Join(output, "ReadersClient.g.cs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(client), `_client.CreateContent(body, "application/merge-patch+json")`) {
+		t.Errorf("ReadersClient.g.cs does not send the merge patch content type:\n%s", client)
+	}
+}
+
+func TestJSONContextTypes(t *testing.T) {
+	models := []modelTemplateData{
+		{Name: "Options", Kind: schemaKindObject},
+		{Name: "Reader", Kind: schemaKindObject},
+		{Name: "ReaderPatch", Kind: schemaKindPatch},
+		{Name: "ReaderStatus", Kind: schemaKindEnum},
+	}
+	clients := []clientTemplateData{{Operations: []operationTemplateData{
+		{Body: &bodyTemplateData{TypeName: "Reader?", ContentType: "application/json"}, ResponseMode: "json", ResponseType: "IEnumerable<Reader>"},
+		{Body: &bodyTemplateData{TypeName: "Stream", ContentType: "application/octet-stream"}, ResponseMode: "string", ResponseType: "string"},
+		{Body: &bodyTemplateData{TypeName: "ReaderPatch", ContentType: "application/merge-patch+json", PatchResource: "Reader"}, ResponseMode: "json", ResponseType: "Reader", ErrorResponses: []errorResponseTemplateData{{ErrorType: "Problem"}}},
+	}}}
+
+	got := jsonContextTypes(models, clients)
+	want := []jsonContextTypeTemplateData{
+		{TypeName: "global::SumUp.Http.ApiError"},
+		{TypeName: "IEnumerable<Reader>"},
+		{TypeName: "IReadOnlyList<global::SumUp.JsonPatchOperation>"},
+		{TypeName: "global::SumUp.JsonObject"},
+		{TypeName: "Options", PropertyName: "OptionsTypeInfo"},
+		{TypeName: "Problem"},
+		{TypeName: "Reader"},
+		{TypeName: "ReaderStatus"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("jsonContextTypes() = %v, want %v", got, want)
+	}
+}
+
 func TestSanitizeText_NormalizesMarkdownForXmlDocs(t *testing.T) {
 	input := "Use [ISO8601](https://example.com) format with `redirect_url`. **Note**: this is required."
 	got := sanitizeText(input)
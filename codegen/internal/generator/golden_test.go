@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"encoding/json"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files under testdata")
+
+// TestRun_OpenAPI31Golden generates the SDK and code samples for a 3.1 fixture
+// covering type arrays, const, $defs, prefixItems and examples arrays, and
+// compares them with testdata/openapi-3.1. Run with -update to accept changes.
+func TestRun_OpenAPI31Golden(t *testing.T) {
+	doc, err := spec.Load(t.Context(), filepath.Join("testdata", "openapi-3.1.json"), spec.LoadOptions{})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	output := t.TempDir()
+	g := New(Config{OutputDir: output, Namespace: "SumUp"})
+	if err := g.Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	diagnostics := g.Diagnostics()
+	if len(diagnostics) != 1 || diagnostics[0].Code != "ignored-keyword" || diagnostics[0].Pointer != "#/components/schemas/Pet/properties/microchip" {
+		t.Fatalf("Diagnostics() = %v, want one ignored-keyword warning for Pet.microchip", diagnostics)
+	}
+	catalog, err := g.Samples(doc, "1.0.0")
+	if err != nil {
+		t.Fatalf("Samples() error = %v", err)
+	}
+	encoded, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal samples: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(output, "samples.json"), append(encoded, '\n'), 0o644); err != nil {
+		t.Fatalf("write samples: %v", err)
+	}
+
+	golden := filepath.Join("testdata", "openapi-3.1")
+	if *updateGolden {
+		if err := os.RemoveAll(golden); err != nil {
+			t.Fatalf("remove golden files: %v", err)
+		}
+		if err := os.CopyFS(golden, os.DirFS(output)); err != nil {
+			t.Fatalf("write golden files: %v", err)
+		}
+		return
+	}
+
+	got := readTree(t, output)
+	want := readTree(t, golden)
+	for name, content := range want {
+		if got[name] != content {
+			t.Errorf("%s differs from the golden file; run go test ./internal/generator -run OpenAPI31Golden -update and review the diff", name)
+		}
+	}
+	for name := range got {
+		if _, ok := want[name]; !ok {
+			t.Errorf("%s is not in the golden files", name)
+		}
+	}
+}
+
+func readTree(t *testing.T, root string) map[string]string {
+	t.Helper()
+
+	files := map[string]string{}
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(relative)] = string(content)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("read %s: %v", root, err)
+	}
+	return files
+}
@@ -0,0 +1,297 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// ReferenceGraph records which component schemas the operations of a
+// document and the schemas themselves reference.
+type ReferenceGraph struct {
+	Operations []GraphOperation `json:"operations"`
+	Schemas    []GraphSchema    `json:"schemas"`
+}
+
+// GraphOperation is an operation and the schemas it uses.
+type GraphOperation struct {
+	// ID is the operationId, or "METHOD path" for operations without one.
+	ID     string `json:"id"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// References are the schemas referenced by the parameters, request body,
+	// responses and callbacks of the operation.
+	References []string `json:"references"`
+	// Models are the References and every schema they reference in turn.
+	Models []string `json:"models"`
+}
+
+// GraphSchema is a component schema, or a 3.1 $defs entry named
+// "Component/$defs/Name".
+type GraphSchema struct {
+	Name    string `json:"name"`
+	Pointer string `json:"pointer"`
+	// References are the schemas this one references.
+	References []string `json:"references"`
+	// Operations are the IDs of the operations whose Models include it.
+	Operations []string `json:"operations"`
+}
+
+// Unreferenced reports whether no operation uses the schema.
+func (s GraphSchema) Unreferenced() bool {
+	return len(s.Operations) == 0
+}
+
+// ReferenceGraph builds the reference graph of the operations that pass the
+// configured filter.
+func (g *Generator) ReferenceGraph(doc *v3.Document) *ReferenceGraph {
+	g.doc = doc
+	graph := &ReferenceGraph{Operations: []GraphOperation{}, Schemas: []GraphSchema{}}
+	schemas := map[string]*GraphSchema{}
+	if doc.Components != nil && doc.Components.Schemas != nil {
+		for name, proxy := range doc.Components.Schemas.FromOldest() {
+			schemas[name] = &GraphSchema{Name: name, Pointer: schemaPointer(name), References: g.schemaReferences(proxy)}
+			schema := g.schemaFromProxy(proxy)
+			if schema == nil || schema.Defs == nil {
+				continue
+			}
+			for defName, def := range schema.Defs.FromOldest() {
+				key := name + "/$defs/" + defName
+				schemas[key] = &GraphSchema{Name: key, Pointer: schemaPointer(key), References: g.schemaReferences(def)}
+			}
+		}
+	}
+
+	if doc.Paths != nil && doc.Paths.PathItems != nil {
+		for rawPath, pathItem := range doc.Paths.PathItems.FromOldest() {
+			if pathItem == nil || pathItem.GetOperations() == nil {
+				continue
+			}
+			for method, op := range pathItem.GetOperations().FromOldest() {
+				if op == nil || !g.config.Filter.Matches(rawPath, op) {
+					continue
+				}
+				method = strings.ToUpper(canonicalMethodName(method))
+				operation := GraphOperation{ID: op.OperationId, Method: method, Path: rawPath}
+				if operation.ID == "" {
+					operation.ID = method + " " + rawPath
+				}
+				operation.References = g.operationReferences(pathItem, op)
+				operation.Models = closure(operation.References, schemas)
+				for _, name := range operation.Models {
+					if schema, ok := schemas[name]; ok {
+						schema.Operations = append(schema.Operations, operation.ID)
+					}
+				}
+				graph.Operations = append(graph.Operations, operation)
+			}
+		}
+	}
+	sort.Slice(graph.Operations, func(i, j int) bool { return graph.Operations[i].ID < graph.Operations[j].ID })
+
+	for _, schema := range schemas {
+		if schema.Operations == nil {
+			schema.Operations = []string{}
+		}
+		sort.Strings(schema.Operations)
+		graph.Schemas = append(graph.Schemas, *schema)
+	}
+	sort.Slice(graph.Schemas, func(i, j int) bool { return graph.Schemas[i].Name < graph.Schemas[j].Name })
+	return graph
+}
+
+// Unreferenced returns the names of the schemas no operation uses.
+func (r *ReferenceGraph) Unreferenced() []string {
+	var names []string
+	for _, schema := range r.Schemas {
+		if schema.Unreferenced() {
+			names = append(names, schema.Name)
+		}
+	}
+	return names
+}
+
+// WriteDOT writes the graph in Graphviz DOT format, operations as boxes and
+// unreferenced schemas dashed.
+func (r *ReferenceGraph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph openapi {\n  rankdir=LR;\n  node [shape=ellipse];\n")
+	for _, operation := range r.Operations {
+		fmt.Fprintf(&b, "  %q [shape=box, label=%q];\n", "op:"+operation.ID, operation.ID+"\n"+operation.Method+" "+operation.Path)
+	}
+	for _, schema := range r.Schemas {
+		if schema.Unreferenced() {
+			fmt.Fprintf(&b, "  %q [style=dashed];\n", schema.Name)
+		} else {
+			fmt.Fprintf(&b, "  %q;\n", schema.Name)
+		}
+	}
+	for _, operation := range r.Operations {
+		for _, reference := range operation.References {
+			fmt.Fprintf(&b, "  %q -> %q;\n", "op:"+operation.ID, reference)
+		}
+	}
+	for _, schema := range r.Schemas {
+		for _, reference := range schema.References {
+			fmt.Fprintf(&b, "  %q -> %q;\n", schema.Name, reference)
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// reachableSchemas returns the schemas used by the operations that pass the
+// filter.
+func (g *Generator) reachableSchemas(doc *v3.Document) map[string]struct{} {
+	reachable := map[string]struct{}{}
+	for _, operation := range g.ReferenceGraph(doc).Operations {
+		for _, name := range operation.Models {
+			reachable[name] = struct{}{}
+		}
+	}
+	return reachable
+}
+
+func (g *Generator) operationReferences(pathItem *v3.PathItem, op *v3.Operation) []string {
+	references := map[string]struct{}{}
+	for _, param := range mergeParameters(pathItem.Parameters, op.Parameters) {
+		if param != nil {
+			g.collectReferences(param.Schema, references)
+		}
+	}
+	if op.RequestBody != nil {
+		g.collectContentReferences(op.RequestBody.Content, references)
+	}
+	if op.Responses != nil {
+		responses := []*v3.Response{op.Responses.Default}
+		if op.Responses.Codes != nil {
+			for _, resp := range op.Responses.Codes.FromOldest() {
+				responses = append(responses, resp)
+			}
+		}
+		for _, resp := range responses {
+			if resp == nil {
+				continue
+			}
+			g.collectContentReferences(resp.Content, references)
+			if resp.Headers != nil {
+				for _, header := range resp.Headers.FromOldest() {
+					if header != nil {
+						g.collectReferences(header.Schema, references)
+					}
+				}
+			}
+		}
+	}
+	// Callback payloads, such as webhook events, are generated for callers to
+	// deserialize, so they count as used by the operation.
+	if op.Callbacks != nil {
+		for _, callback := range op.Callbacks.FromOldest() {
+			if callback == nil || callback.Expression == nil {
+				continue
+			}
+			for _, callbackPath := range callback.Expression.FromOldest() {
+				if callbackPath == nil || callbackPath.GetOperations() == nil {
+					continue
+				}
+				for _, callbackOp := range callbackPath.GetOperations().FromOldest() {
+					if callbackOp == nil {
+						continue
+					}
+					for _, name := range g.operationReferences(callbackPath, callbackOp) {
+						references[name] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+	return sortedNames(references)
+}
+
+// schemaReferences returns the schemas referenced by the body of a component
+// schema.
+func (g *Generator) schemaReferences(proxy *base.SchemaProxy) []string {
+	references := map[string]struct{}{}
+	if schema := g.schemaFromProxy(proxy); schema != nil {
+		g.collectSubschemaReferences(schema, references)
+	}
+	return sortedNames(references)
+}
+
+func (g *Generator) collectContentReferences(content *orderedmap.Map[string, *v3.MediaType], references map[string]struct{}) {
+	if content == nil {
+		return
+	}
+	for _, mediaType := range content.FromOldest() {
+		if mediaType != nil {
+			g.collectReferences(mediaType.Schema, references)
+		}
+	}
+}
+
+// collectReferences adds the schema proxy refers to, or the schemas its
+// inline subschemas refer to.
+func (g *Generator) collectReferences(proxy *base.SchemaProxy, references map[string]struct{}) {
+	if proxy == nil {
+		return
+	}
+	if proxy.IsReference() {
+		references[componentName(proxy.GetReference())] = struct{}{}
+		return
+	}
+	if schema := g.schemaFromProxy(proxy); schema != nil {
+		g.collectSubschemaReferences(schema, references)
+	}
+}
+
+func (g *Generator) collectSubschemaReferences(schema *base.Schema, references map[string]struct{}) {
+	if schema.Properties != nil {
+		for _, property := range schema.Properties.FromOldest() {
+			g.collectReferences(property, references)
+		}
+	}
+	if schema.Items != nil && schema.Items.IsA() {
+		g.collectReferences(schema.Items.A, references)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		g.collectReferences(schema.AdditionalProperties.A, references)
+	}
+	for _, group := range [][]*base.SchemaProxy{schema.AllOf, schema.OneOf, schema.AnyOf, schema.PrefixItems} {
+		for _, subschema := range group {
+			g.collectReferences(subschema, references)
+		}
+	}
+}
+
+// closure returns names and every schema they reference, transitively.
+func closure(names []string, schemas map[string]*GraphSchema) []string {
+	seen := map[string]struct{}{}
+	pending := append([]string(nil), names...)
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		if schema, ok := schemas[name]; ok {
+			pending = append(pending, schema.References...)
+		}
+	}
+	return sortedNames(seen)
+}
+
+func sortedNames(set map[string]struct{}) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
+)
+
+func TestReferenceGraph(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+
+	graph := New(Config{}).ReferenceGraph(doc)
+
+	var operations []string
+	for _, operation := range graph.Operations {
+		operations = append(operations, operation.ID+" "+strings.Join(operation.References, ",")+" "+strings.Join(operation.Models, ","))
+	}
+	want := []string{
+		"CreateCheckout Checkout Checkout,Money",
+		"GetHealth  ",
+		"ListCheckouts Checkout Checkout,Money",
+		"ListReaders  ",
+	}
+	if strings.Join(operations, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("operations = %q, want %q", operations, want)
+	}
+	if got := graph.Unreferenced(); strings.Join(got, ",") != "Reader" {
+		t.Fatalf("Unreferenced() = %v, want [Reader]", got)
+	}
+
+	var dot bytes.Buffer
+	if err := graph.WriteDOT(&dot); err != nil {
+		t.Fatalf("WriteDOT() error = %v", err)
+	}
+	for _, line := range []string{
+		`  "op:CreateCheckout" [shape=box, label="CreateCheckout\nPOST /v0.1/checkouts"];`,
+		`  "Reader" [style=dashed];`,
+		`  "op:CreateCheckout" -> "Checkout";`,
+		`  "Checkout" -> "Money";`,
+	} {
+		if !strings.Contains(dot.String(), line+"\n") {
+			t.Errorf("WriteDOT() = %s, want it to contain %s", dot.String(), line)
+		}
+	}
+}
+
+func TestBuildModels_ReportsUnreferencedSchemas(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+
+	for _, prune := range []bool{false, true} {
+		g := New(Config{Namespace: "SumUp", PruneUnreferenced: prune})
+		models, err := g.buildModels(doc)
+		if err != nil {
+			t.Fatalf("buildModels() error = %v", err)
+		}
+		generated := false
+		for _, model := range models {
+			generated = generated || model.Name == "Reader"
+		}
+		if generated == prune {
+			t.Errorf("PruneUnreferenced = %t: Reader generated = %t", prune, generated)
+		}
+
+		var unreferenced []diag.Diagnostic
+		for _, diagnostic := range g.Diagnostics() {
+			if diagnostic.Code == "unreferenced-schema" {
+				unreferenced = append(unreferenced, diagnostic)
+			}
+		}
+		if len(unreferenced) != 1 || unreferenced[0].Severity != diag.Info || unreferenced[0].Pointer != "#/components/schemas/Reader" {
+			t.Errorf("PruneUnreferenced = %t: unreferenced-schema diagnostics = %v", prune, unreferenced)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package generator
+
+import (
+	"sort"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-dotnet/codegen/pkg/ir"
+)
+
+// IR builds the SDK for doc without writing any files and returns its public
+// intermediate representation, projected from the template data Run renders.
+// Descriptions are plain text.
+func (g *Generator) IR(doc *v3.Document) (*ir.API, error) {
+	built, err := g.build(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	api := &ir.API{
+		Version:     ir.Version,
+		APIVersion:  built.apiVersion,
+		Namespace:   g.config.Namespace,
+		Clients:     make([]ir.Client, 0, len(built.clients)),
+		Models:      make([]ir.Model, 0, len(built.models)),
+		Options:     make([]ir.Options, 0, len(built.options)),
+		Diagnostics: make([]ir.Diagnostic, 0, len(g.diagnostics)),
+	}
+	if doc.Info != nil {
+		api.Title = strings.TrimSpace(doc.Info.Title)
+	}
+
+	optionsOperations := map[string]string{}
+	for _, client := range built.clients {
+		irClient := ir.Client{
+			Name:        client.ClientName,
+			Property:    client.PropertyName,
+			Tag:         client.Tag,
+			Description: plainText(client.TagDescription),
+			Operations:  make([]ir.Operation, 0, len(client.Operations)),
+		}
+		for _, operation := range client.Operations {
+			irClient.Operations = append(irClient.Operations, irOperation(operation))
+			if operation.OperationOptions != nil {
+				optionsOperations[operation.OperationOptions.Name] = operation.Pointer
+			}
+		}
+		api.Clients = append(api.Clients, irClient)
+	}
+	for _, model := range built.models {
+		api.Models = append(api.Models, irModel(model))
+	}
+	for _, options := range built.options {
+		irOptions := ir.Options{
+			Name:       options.Name,
+			Operation:  optionsOperations[options.Name],
+			Properties: make([]ir.OptionsProperty, 0, len(options.Properties)),
+			Required:   options.Required,
+		}
+		for _, property := range options.Properties {
+			irOptions.Properties = append(irOptions.Properties, ir.OptionsProperty{
+				Name:        property.PropertyName,
+				Type:        property.TypeName,
+				Description: plainText(property.Description),
+				Required:    property.Required,
+				Default:     property.Default,
+			})
+		}
+		api.Options = append(api.Options, irOptions)
+	}
+	for _, diagnostic := range g.Diagnostics() {
+		api.Diagnostics = append(api.Diagnostics, ir.Diagnostic{
+			Code:     diagnostic.Code,
+			Severity: diagnostic.Severity.String(),
+			Pointer:  diagnostic.Pointer,
+			Line:     diagnostic.Line,
+			Column:   diagnostic.Column,
+			Message:  diagnostic.Message,
+		})
+	}
+	return api, nil
+}
+
+func irOperation(operation operationTemplateData) ir.Operation {
+	result := ir.Operation{
+		ID:          operation.OperationID,
+		Method:      operation.MethodName,
+		HTTPMethod:  strings.ToUpper(operation.HttpMethod),
+		Path:        operation.Path,
+		Summary:     plainText(operation.Summary),
+		Description: plainText(operation.Description),
+		Pointer:     operation.Pointer,
+		Parameters:  make([]ir.Parameter, 0, len(operation.PathParams)+len(operation.QueryParams)+len(operation.HeaderParams)),
+		Response:    ir.Response{Type: operation.ResponseType, Mode: operation.ResponseMode},
+		Deprecated:  operation.Deprecated,
+	}
+	for _, group := range [][]parameterTemplateData{operation.PathParams, operation.QueryParams, operation.HeaderParams} {
+		for _, parameter := range group {
+			irParameter := ir.Parameter{
+				Name:        parameter.Name,
+				In:          parameter.Location,
+				Type:        parameter.TypeName,
+				Description: plainText(parameter.Description),
+				Required:    parameter.Required,
+				Default:     parameter.Default,
+				Example:     parameter.Example,
+				Pointer:     parameter.Pointer,
+			}
+			if parameter.Location == "path" {
+				irParameter.Argument = parameter.ArgName
+				irParameter.BuilderCall = parameter.BuilderCall
+			} else {
+				irParameter.Property = parameter.PropertyName
+				irParameter.BuilderCall = parameter.OptionsBuilderCall
+			}
+			result.Parameters = append(result.Parameters, irParameter)
+		}
+	}
+	if operation.Body != nil {
+		result.RequestBody = &ir.Body{
+			Argument:    operation.Body.ArgName,
+			ContentType: operation.Body.ContentType,
+			Type:        operation.Body.TypeName,
+			Description: plainText(operation.Body.Description),
+			Required:    operation.Body.Required,
+		}
+	}
+	for _, response := range operation.ErrorResponses {
+		status := response.StatusCodeLiteral
+		if response.IsDefault {
+			status = "default"
+		}
+		result.Errors = append(result.Errors, ir.ErrorResponse{Status: status, Type: response.ErrorType})
+	}
+	if operation.OperationOptions != nil {
+		result.Options = operation.OperationOptions.Name
+	}
+	return result
+}
+
+func irModel(model modelTemplateData) ir.Model {
+	result := ir.Model{
+		Name:        model.Name,
+		Kind:        ir.ModelClass,
+		Description: plainText(model.Description),
+		Pointer:     model.Pointer,
+		Deprecated:  model.Deprecated,
+	}
+	switch {
+	case model.Kind == schemaKindEnum:
+		result.Kind = ir.ModelEnum
+		for _, value := range model.EnumValues {
+			result.Values = append(result.Values, ir.EnumValue{Name: value.Name, Value: value.Value})
+		}
+		return result
+	case model.Kind == schemaKindPatch:
+		result.Kind = ir.ModelPatch
+		result.Base = model.BaseType
+		result.Resource = model.PatchResource
+		result.Format = model.PatchFormat
+		return result
+	case model.IsDictionaryModel:
+		result.Kind = ir.ModelDictionary
+		result.AdditionalProperties = model.DictionaryValueType
+		return result
+	case model.HasExtensionData:
+		result.AdditionalProperties = model.ExtensionDataValueType
+	}
+	result.Base = model.BaseType
+	result.InputOf = model.InputOf
+	result.Record = model.IsRecord
+	for _, property := range model.InheritedProperties {
+		result.Properties = append(result.Properties, irProperty(property, true))
+	}
+	for _, property := range model.Properties {
+		result.Properties = append(result.Properties, irProperty(property, false))
+	}
+	sort.SliceStable(result.Properties, func(i, j int) bool {
+		return result.Properties[i].JSONName < result.Properties[j].JSONName
+	})
+	return result
+}
+
+func irProperty(property modelPropertyTemplateData, inherited bool) ir.Property {
+	return ir.Property{
+		Name:        property.PropertyName,
+		JSONName:    property.JsonName,
+		Type:        property.TypeName,
+		Description: plainText(property.Description),
+		Required:    property.Required,
+		Nullable:    property.IsNullable,
+		Optional:    property.IsOptional,
+		ReadOnly:    property.IsReadOnly,
+		Deprecated:  property.Deprecated,
+		Inherited:   inherited,
+		Default:     property.Default,
+		Pointer:     property.Pointer,
+	}
+}
+
+// plainText undoes the XML escaping of template descriptions.
+func plainText(value string) string {
+	return strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&").Replace(value)
+}
@@ -0,0 +1,140 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/pkg/ir"
+)
+
+const irSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "Readers API", "version": "2.1.0" },
+  "tags": [{ "name": "Readers", "description": "Card readers & terminals." }],
+  "paths": {
+    "/v0.1/merchants/{merchant_code}/readers": {
+      "parameters": [{ "name": "merchant_code", "in": "path", "required": true, "schema": { "type": "string" }, "example": "MH4H92C7" }],
+      "get": {
+        "tags": ["Readers"],
+        "operationId": "ListReaders",
+        "summary": "List readers",
+        "parameters": [{ "name": "limit", "in": "query", "schema": { "type": "integer" } }],
+        "responses": {
+          "200": { "description": "ok", "content": { "application/json": { "schema": { "type": "array", "items": { "$ref": "#/components/schemas/Reader" } } } } },
+          "404": { "description": "missing", "content": { "application/json": { "schema": { "$ref": "#/components/schemas/NotFound" } } } }
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Reader": {
+        "type": "object",
+        "description": "A <paired> reader.",
+        "required": ["id"],
+        "properties": {
+          "id": { "type": "string" },
+          "status": { "type": "string", "enum": ["online", "offline"] }
+        }
+      },
+      "NotFound": {
+        "type": "object",
+        "properties": { "message": { "type": "string" } }
+      }
+    }
+  }
+}`
+
+func TestIR(t *testing.T) {
+	doc := mustBuildV3Document(t, irSpec)
+
+	api, err := New(Config{}).IR(doc)
+	if err != nil {
+		t.Fatalf("IR() error = %v", err)
+	}
+	if api.Version != ir.Version || api.Title != "Readers API" || api.APIVersion != "2.1.0" || api.Namespace != "SumUp" {
+		t.Fatalf("API = %+v", api)
+	}
+
+	if len(api.Clients) != 1 || api.Clients[0].Name != "Readers" || api.Clients[0].Tag != "Readers" {
+		t.Fatalf("Clients = %+v", api.Clients)
+	}
+	if got := api.Clients[0].Description; got != "Card readers & terminals." {
+		t.Errorf("client description = %q, want plain text", got)
+	}
+	operation := api.Clients[0].Operations[0]
+	if operation.ID != "ListReaders" || operation.HTTPMethod != "GET" || operation.Pointer != "#/paths/~1v0.1~1merchants~1{merchant_code}~1readers/get" {
+		t.Fatalf("operation = %+v", operation)
+	}
+	if operation.Response != (ir.Response{Type: "IEnumerable<Reader>", Mode: "json"}) {
+		t.Errorf("response = %+v", operation.Response)
+	}
+	if len(operation.Errors) != 1 || operation.Errors[0] != (ir.ErrorResponse{Status: "404", Type: "NotFound"}) {
+		t.Errorf("errors = %+v", operation.Errors)
+	}
+	if len(operation.Parameters) != 2 {
+		t.Fatalf("parameters = %+v", operation.Parameters)
+	}
+	merchantCode, limit := operation.Parameters[0], operation.Parameters[1]
+	if merchantCode.In != "path" || merchantCode.Argument != "merchantCode" || merchantCode.Example != "MH4H92C7" || merchantCode.Pointer != "#/paths/~1v0.1~1merchants~1{merchant_code}~1readers/parameters/0" {
+		t.Errorf("path parameter = %+v", merchantCode)
+	}
+	if merchantCode.BuilderCall != `builder.AddPath("merchant_code", merchantCode);` {
+		t.Errorf("path parameter builder call = %q", merchantCode.BuilderCall)
+	}
+	if limit.In != "query" || limit.Property != "Limit" || limit.BuilderCall != `builder.AddQuery("limit", operationOptions.Limit);` || limit.Pointer != "#/paths/~1v0.1~1merchants~1{merchant_code}~1readers/get/parameters/0" {
+		t.Errorf("query parameter = %+v", limit)
+	}
+
+	if len(api.Options) != 1 || api.Options[0].Name != operation.Options || api.Options[0].Operation != operation.Pointer {
+		t.Fatalf("Options = %+v, want the options of %s", api.Options, operation.ID)
+	}
+
+	models := map[string]ir.Model{}
+	for _, model := range api.Models {
+		models[model.Name] = model
+	}
+	reader := models["Reader"]
+	if reader.Kind != ir.ModelClass || reader.Pointer != "#/components/schemas/Reader" || reader.Description != "A <paired> reader." {
+		t.Fatalf("Reader = %+v", reader)
+	}
+	if len(reader.Properties) != 2 || reader.Properties[0].JSONName != "id" || !reader.Properties[0].Required || reader.Properties[0].Nullable || !reader.Properties[1].Nullable || reader.Properties[1].Pointer != "#/components/schemas/Reader/properties/status" {
+		t.Errorf("Reader properties = %+v", reader.Properties)
+	}
+	status, ok := models[strings.TrimSuffix(reader.Properties[1].Type, "?")]
+	if !ok || status.Kind != ir.ModelEnum || status.Pointer != "#/components/schemas/Reader/properties/status" || len(status.Values) != 2 {
+		t.Errorf("inline enum = %+v", status)
+	}
+}
+
+// The C# output and the representation are built by the same step, so every
+// type the representation names is generated.
+func TestIR_MatchesRenderedSDK(t *testing.T) {
+	doc := mustBuildV3Document(t, irSpec)
+	api, err := New(Config{}).IR(doc)
+	if err != nil {
+		t.Fatalf("IR() error = %v", err)
+	}
+	output := t.TempDir()
+	if err := New(Config{OutputDir: output, Namespace: "SumUp"}).Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	var want []string
+	for _, client := range api.Clients {
+		want = append(want, client.Name+"Client.g.cs")
+	}
+	for _, model := range api.Models {
+		want = append(want, filepath.Join("Models", model.Name+".g.cs"))
+	}
+	for _, options := range api.Options {
+		want = append(want, filepath.Join("Options", options.Name+".g.cs"))
+	}
+	for _, file := range want {
+		if _, err := os.Stat(filepath.Join(output, file)); err != nil {
+			t.Errorf("%s not generated: %v", file, err)
+		}
+	}
+}
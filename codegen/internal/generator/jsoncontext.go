@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// jsonContextName is the System.Text.Json source generation context the
+// clients read their type metadata from.
+const jsonContextName = "SumUpJsonContext"
+
+// runtimeJSONTypes maps the types of the hand-written runtime the clients
+// serialize, the fallback error, JSON Patch documents and free-form objects,
+// to their fully qualified names. The runtime keeps its SumUp namespaces
+// whatever namespace the generated code is configured with.
+var runtimeJSONTypes = map[string]string{
+	"ApiError":                          "global::SumUp.Http.ApiError",
+	"IReadOnlyList<JsonPatchOperation>": "IReadOnlyList<global::SumUp.JsonPatchOperation>",
+	"JsonObject":                        "global::SumUp.JsonObject",
+}
+
+// jsonContextMembers are the members of JsonSerializerContext a generated
+// metadata property cannot be named after.
+var jsonContextMembers = map[string]struct{}{
+	"Default":                    {},
+	"GeneratedSerializerOptions": {},
+	"GetTypeInfo":                {},
+	"Options":                    {},
+}
+
+// jsonContextTemplateData is the data of json_context.tmpl.
+type jsonContextTemplateData struct {
+	Namespace string
+	Name      string
+	Types     []jsonContextTypeTemplateData
+}
+
+// jsonContextTypeTemplateData is a type the context generates metadata for.
+type jsonContextTypeTemplateData struct {
+	TypeName string
+	// PropertyName names the metadata property of types named after a member
+	// of JsonSerializerContext, and is empty otherwise.
+	PropertyName string
+}
+
+// jsonContextTypes returns the runtime types, models, JSON request bodies,
+// JSON responses and error types, ordered by name. Patch builders serialize
+// themselves and are left out.
+func jsonContextTypes(models []modelTemplateData, clients []clientTemplateData) []jsonContextTypeTemplateData {
+	seen := map[string]struct{}{}
+	add := func(typeName string) {
+		if typeName = strings.TrimSuffix(typeName, "?"); typeName != "" {
+			seen[typeName] = struct{}{}
+		}
+	}
+	for typeName := range runtimeJSONTypes {
+		add(typeName)
+	}
+	for _, model := range models {
+		if model.Kind != schemaKindPatch {
+			add(model.Name)
+		}
+	}
+	for _, client := range clients {
+		for _, operation := range client.Operations {
+			if operation.Body != nil && operation.Body.PatchResource == "" && strings.Contains(operation.Body.ContentType, "json") {
+				add(operation.Body.TypeName)
+			}
+			if operation.ResponseMode == "json" {
+				add(operation.ResponseType)
+			}
+			for _, response := range operation.ErrorResponses {
+				add(response.ErrorType)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for typeName := range seen {
+		names = append(names, typeName)
+	}
+	sort.Strings(names)
+	types := make([]jsonContextTypeTemplateData, 0, len(names))
+	for _, typeName := range names {
+		entry := jsonContextTypeTemplateData{TypeName: typeName}
+		if qualified, ok := runtimeJSONTypes[typeName]; ok {
+			entry.TypeName = qualified
+		}
+		if property := jsonContextProperty(typeName); property != typeName {
+			entry.PropertyName = property
+		}
+		types = append(types, entry)
+	}
+	return types
+}
+
+// jsonContextProperty returns the name of the metadata property of a model
+// in the context, e.g. SumUpJsonContext.Default.Checkout.
+func jsonContextProperty(typeName string) string {
+	if _, ok := jsonContextMembers[typeName]; ok {
+		return typeName + "TypeInfo"
+	}
+	return typeName
+}
+
+func (g *Generator) renderJSONContext(t *template.Template, models []modelTemplateData, clients []clientTemplateData) error {
+	data := jsonContextTemplateData{
+		Namespace: g.config.Namespace,
+		Name:      jsonContextName,
+		Types:     jsonContextTypes(models, clients),
+	}
+	if err := renderFile(t, filepath.Join(g.config.OutputDir, jsonContextName+".g.cs"), "json_context.tmpl", data); err != nil {
+		return fmt.Errorf("render json context: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package generator
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+)
+
+// Patch builder formats.
+const (
+	patchFormatMerge = "merge" // JSON Merge Patch, RFC 7396
+	patchFormatJSON  = "json"  // JSON Patch, RFC 6902
+)
+
+// patchContentTypes maps the patch media types to the format of the builder
+// their operations take.
+var patchContentTypes = []struct{ contentType, format string }{
+	{"application/merge-patch+json", patchFormatMerge},
+	{"application/json-patch+json", patchFormatJSON},
+}
+
+// partialUpdateSchemas returns the JSON pointers of the request body schemas
+// of the PATCH operations the build generates: the referenced component, or
+// the inline schema promoted to a model. Components used elsewhere too get a
+// separate update variant instead, recorded in g.updateVariants, so their
+// other uses keep plain properties.
+func (g *Generator) partialUpdateSchemas(doc *v3.Document) map[string]struct{} {
+	pointers := map[string]struct{}{}
+	g.updateVariants = map[string]struct{}{}
+	if doc.Paths == nil || doc.Paths.PathItems == nil {
+		return pointers
+	}
+	var shared map[string]struct{}
+	for rawPath, pathItem := range doc.Paths.PathItems.FromOldest() {
+		if pathItem == nil || pathItem.Patch == nil || pathItem.Patch.RequestBody == nil {
+			continue
+		}
+		if !g.config.Filter.Matches(rawPath, pathItem.Patch) {
+			continue
+		}
+		content := pathItem.Patch.RequestBody.Content
+		contentType := preferredContentType(content)
+		if contentType == "" {
+			continue
+		}
+		schemaRef := content.GetOrZero(contentType).Schema
+		pointer := spec.Pointer("paths", rawPath, strings.ToLower(http.MethodPatch), "requestBody", "content", contentType, "schema")
+		if schemaRef.IsReference() {
+			// Input variants are partial updates instead of the component.
+			if g.splitsReadWrite() && g.hasReadWriteProperties(g.schemaFromProxy(schemaRef), map[*base.Schema]bool{}) {
+				continue
+			}
+			component := componentName(schemaRef.GetReference())
+			if shared == nil {
+				shared = g.sharedComponents(doc)
+			}
+			if _, ok := shared[component]; ok {
+				g.updateVariants[component] = struct{}{}
+				g.warn(pointer, "shared-partial-update", "%s is also used outside PATCH request bodies; the body is generated as a separate %s%s partial update", component, g.modelName(component), inputSuffixUpdate)
+				continue
+			}
+			pointers[schemaPointer(component)] = struct{}{}
+			continue
+		}
+		pointers[pointer] = struct{}{}
+	}
+	return pointers
+}
+
+// sharedComponents returns the component schemas used other than as the
+// $ref request body of a PATCH operation: by other schemas, or by the
+// parameters, responses, callbacks and other request bodies of operations.
+func (g *Generator) sharedComponents(doc *v3.Document) map[string]struct{} {
+	shared := map[string]struct{}{}
+	for _, schema := range g.ReferenceGraph(doc).Schemas {
+		for _, reference := range schema.References {
+			if reference != schema.Name {
+				shared[reference] = struct{}{}
+			}
+		}
+	}
+	for rawPath, pathItem := range doc.Paths.PathItems.FromOldest() {
+		if pathItem == nil || pathItem.GetOperations() == nil {
+			continue
+		}
+		for method, op := range pathItem.GetOperations().FromOldest() {
+			if op == nil || !g.config.Filter.Matches(rawPath, op) {
+				continue
+			}
+			if !strings.EqualFold(method, http.MethodPatch) || op.RequestBody == nil {
+				for _, name := range g.operationReferences(pathItem, op) {
+					shared[name] = struct{}{}
+				}
+				continue
+			}
+			withoutBody := *op
+			withoutBody.RequestBody = nil
+			for _, name := range g.operationReferences(pathItem, &withoutBody) {
+				shared[name] = struct{}{}
+			}
+			if op.RequestBody.Content == nil {
+				continue
+			}
+			references := map[string]struct{}{}
+			for _, media := range op.RequestBody.Content.FromOldest() {
+				if media != nil && media.Schema != nil && !media.Schema.IsReference() {
+					g.collectReferences(media.Schema, references)
+				}
+			}
+			for name := range references {
+				shared[name] = struct{}{}
+			}
+		}
+	}
+	return shared
+}
+
+// isPartialUpdate reports whether the class generated at pointer from schema
+// is a partial update, whose optional properties tell an omitted value from
+// an explicit null.
+func (g *Generator) isPartialUpdate(pointer string, schema *base.Schema) bool {
+	if _, ok := g.partialUpdates[pointer]; ok {
+		return true
+	}
+	if schema == nil || schema.Extensions == nil {
+		return false
+	}
+	return codegenExtensionFlag(schema.Extensions.GetOrZero(CodegenExtension), PartialUpdateExtension)
+}
+
+// codegenExtensionFlag reports whether the x-codegen mapping node sets key to
+// true.
+func codegenExtensionFlag(node *yaml.Node, key string) bool {
+	return codegenExtensionValue(node, key) == "true"
+}
+
+// codegenExtensionValue returns the scalar value of key in the x-codegen
+// mapping node, or an empty string.
+func codegenExtensionValue(node *yaml.Node, key string) string {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key && node.Content[i+1].Kind == yaml.ScalarNode {
+			return strings.TrimSpace(node.Content[i+1].Value)
+		}
+	}
+	return ""
+}
+
+// optionalProperties wraps the optional, writable properties of a partial
+// update in Optional<T>, which is omitted from the JSON while unset and
+// written as null when set to null.
+func optionalProperties(properties []modelPropertyTemplateData) {
+	for i, property := range properties {
+		if property.Required || property.IsReadOnly {
+			continue
+		}
+		properties[i].TypeName = "Optional<" + property.TypeName + ">"
+		properties[i].IsOptional = true
+		properties[i].IsValueType = true
+		properties[i].IsNullable = false
+		properties[i].NeedsInitializer = false
+		// Unset properties leave the field, and so its default, to the server.
+		properties[i].DefaultLiteral = ""
+	}
+}
+
+// buildPatchBody returns the body of an operation that takes a patch builder:
+// one whose request body is a merge patch or JSON patch document, or which
+// x-codegen.patch_builder marks. It returns nil for other operations and when
+// the resource the builder diffs cannot be determined.
+func (g *Generator) buildPatchBody(op *v3.Operation) *bodyTemplateData {
+	if op.RequestBody == nil || op.RequestBody.Content == nil {
+		return nil
+	}
+	defer g.at(spec.AppendPointer(g.location, "requestBody"))()
+	format, contentType := "", ""
+	for _, candidate := range patchContentTypes {
+		if op.RequestBody.Content.GetOrZero(candidate.contentType) != nil {
+			format, contentType = candidate.format, candidate.contentType
+			break
+		}
+	}
+	if format == "" && op.Extensions != nil {
+		switch value := codegenExtensionValue(op.Extensions.GetOrZero(CodegenExtension), PatchBuilderExtension); value {
+		case "":
+		case patchFormatMerge, patchFormatJSON:
+			format, contentType = value, firstContentType(op.RequestBody.Content)
+		default:
+			g.warn("", "unsupported-patch-builder", "x-codegen.%s %q is neither %s nor %s; the operation is generated without a patch builder", PatchBuilderExtension, value, patchFormatMerge, patchFormatJSON)
+		}
+	}
+	if format == "" {
+		return nil
+	}
+
+	resource := g.patchResource(op, contentType)
+	if resource == nil {
+		g.warn("", "unsupported-patch-builder", "neither the response nor the request body references an object schema to diff; the operation is generated without a patch builder")
+		return nil
+	}
+	required := op.RequestBody.Required != nil && *op.RequestBody.Required
+	typeName := g.patchBuilder(resource.TypeName, format)
+	signature := typeName + " body"
+	if !required {
+		signature = typeName + "? body = null"
+	}
+	return &bodyTemplateData{
+		ArgName:       "body",
+		Signature:     signature,
+		Description:   sanitizeText(op.RequestBody.Description),
+		Required:      required,
+		ContentType:   contentType,
+		TypeName:      typeName,
+		PatchResource: resource.TypeName,
+	}
+}
+
+// patchResource returns the component a patch builder diffs copies of: the
+// object model of the first successful response or, failing that, of the
+// request body.
+func (g *Generator) patchResource(op *v3.Operation, contentType string) *schemaTypeInfo {
+	var candidates []*base.SchemaProxy
+	if op.Responses != nil && op.Responses.Codes != nil {
+		codes := make([]string, 0, op.Responses.Codes.Len())
+		for code := range op.Responses.Codes.KeysFromOldest() {
+			if strings.HasPrefix(code, "2") {
+				codes = append(codes, code)
+			}
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			if response := op.Responses.Codes.GetOrZero(code); response != nil {
+				candidates = append(candidates, preferredSchema(response.Content))
+			}
+		}
+	}
+	if media := op.RequestBody.Content.GetOrZero(contentType); media != nil {
+		candidates = append(candidates, media.Schema)
+	}
+	for _, candidate := range candidates {
+		if candidate == nil || !candidate.IsReference() {
+			continue
+		}
+		if info := g.schemaTypes[componentName(candidate.GetReference())]; info != nil && info.Kind == schemaKindObject {
+			return info
+		}
+	}
+	return nil
+}
+
+// patchBuilder returns the name of the builder of resource patches in format,
+// declaring the builder the first time: ReaderPatch for merge patches and
+// ReaderJsonPatch for JSON patches of Reader.
+func (g *Generator) patchBuilder(resource, format string) string {
+	key := format + " " + resource
+	if name, ok := g.patchBuilders[key]; ok {
+		return name
+	}
+	suffix := "Patch"
+	baseType := "JsonMergePatch"
+	if format == patchFormatJSON {
+		suffix = "JsonPatch"
+		baseType = "JsonPatch"
+	}
+	name := g.reserveModelName(resource + suffix)
+	g.patchBuilders[key] = name
+	g.inlineModels = append(g.inlineModels, modelTemplateData{
+		Namespace:     g.config.Namespace,
+		Name:          name,
+		Kind:          schemaKindPatch,
+		BaseType:      baseType,
+		PatchResource: resource,
+		PatchFormat:   format,
+		UsesJson:      true,
+		Pointer:       g.location,
+	})
+	return name
+}
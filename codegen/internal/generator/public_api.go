@@ -0,0 +1,110 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// publicAPIFile lists the public surface of the generated code, one
+// declaration per line, so reviews see API changes without reading every
+// regenerated file.
+const publicAPIFile = "PublicAPI.g.txt"
+
+func (g *Generator) renderPublicAPI(models []modelTemplateData, options []optionsTemplateData, clients []clientTemplateData) error {
+	content := "#nullable enable\n" + strings.Join(publicAPILines(g.config.Namespace, models, options, clients), "\n") + "\n"
+	if err := os.WriteFile(filepath.Join(g.config.OutputDir, publicAPIFile), []byte(content), 0o644); err != nil {
+		return fmt.Errorf("write public API snapshot: %w", err)
+	}
+	return nil
+}
+
+// publicAPILines returns the sorted declarations of every public type and
+// member the templates emit. Types read "Namespace.Name (kind)", members
+// "Namespace.Type.Member signature -> type" and enum members
+// "Namespace.Enum.Member = "value"".
+func publicAPILines(namespace string, models []modelTemplateData, options []optionsTemplateData, clients []clientTemplateData) []string {
+	var lines []string
+	add := func(format string, args ...any) {
+		lines = append(lines, namespace+"."+fmt.Sprintf(format, args...))
+	}
+
+	for _, model := range models {
+		kind := "class"
+		if model.IsRecord {
+			kind = "record"
+		}
+		switch {
+		case model.Kind == schemaKindEnum:
+			add("%s (enum)", model.Name)
+			for _, value := range model.EnumValues {
+				add("%s.%s = %q", model.Name, value.Name, value.Value)
+			}
+			continue
+		case model.IsDictionaryModel && model.DictionaryBaseType != "":
+			add("%s (class) : %s", model.Name, model.DictionaryBaseType)
+		case model.IsDictionaryModel:
+			add("%s (class) : Dictionary<string, %s>", model.Name, model.DictionaryValueType)
+		case model.BaseType != "":
+			add("%s (%s) : %s", model.Name, kind, model.BaseType)
+		default:
+			add("%s (%s)", model.Name, kind)
+		}
+		if model.Kind == schemaKindPatch {
+			add("%s.From(%s original, %s modified) -> %s", model.Name, model.PatchResource, model.PatchResource, model.Name)
+			continue
+		}
+		if model.IsDictionaryModel {
+			continue
+		}
+		setter := "set"
+		if model.IsRecord {
+			setter = "init"
+		}
+		for _, property := range model.Properties {
+			accessors := "{ get; " + setter + "; }"
+			if property.IsReadOnly {
+				accessors = "{ get; }"
+			}
+			if property.IsRequiredMember {
+				accessors = "required " + accessors
+			}
+			add("%s.%s %s -> %s", model.Name, property.PropertyName, accessors, property.TypeName)
+		}
+		if model.HasExtensionData {
+			add("%s.AdditionalProperties { get; %s; } -> IDictionary<string, %s>", model.Name, setter, model.ExtensionDataValueType)
+		}
+		if model.EmitToString {
+			add("%s.ToString() -> string", model.Name)
+		}
+	}
+
+	for _, option := range options {
+		add("%s (class)", option.Name)
+		for _, property := range option.Properties {
+			add("%s.%s { get; set; } -> %s", option.Name, property.PropertyName, property.TypeName)
+		}
+	}
+
+	add("%s (class) : JsonSerializerContext", jsonContextName)
+	add("SumUpClient (class)")
+	for _, client := range clients {
+		add("SumUpClient.%s { get; } -> %sClient", client.PropertyName, client.ClientName)
+		add("%sClient (class)", client.ClientName)
+		for _, operation := range client.Operations {
+			parameters := make([]string, 0, len(operation.Parameters)+2)
+			for _, parameter := range operation.Parameters {
+				parameters = append(parameters, parameter.Signature)
+			}
+			parameters = append(parameters, "RequestOptions? requestOptions = null", "CancellationToken cancellationToken = default")
+			signature := strings.Join(parameters, ", ")
+			add("%sClient.%s(%s) -> ApiResponse<%s>", client.ClientName, operation.MethodName, signature, operation.ResponseType)
+			add("%sClient.%sAsync(%s) -> Task<ApiResponse<%s>>", client.ClientName, operation.MethodName, signature, operation.ResponseType)
+		}
+	}
+
+	sort.Strings(lines)
+	return lines
+}
@@ -0,0 +1,168 @@
+package generator
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+)
+
+var (
+	publicNamespacePattern = regexp.MustCompile(`^namespace ([\w.]+);$`)
+	publicTypePattern      = regexp.MustCompile(`^public (?:sealed )?(?:partial )?(class|record|enum) (\w+)(?: : (.+))?$`)
+	publicMethodPattern    = regexp.MustCompile(`^    public (?:static )?(?:async )?(?:override )?(.+) (\w+)\((.*)\)$`)
+	publicPropertyPattern  = regexp.MustCompile(`^    public (required )?(.+) (\w+) \{ get;( private| internal)? (set|init); \}`)
+	enumMemberValuePattern = regexp.MustCompile(`^    \[EnumMember\(Value = "(.*)"\)\]$`)
+	enumMemberPattern      = regexp.MustCompile(`^    (\w+),$`)
+)
+
+// TestPublicAPI_MatchesCommittedSDK guards against the committed snapshot and
+// the committed generated code drifting apart, e.g. through hand edits.
+func TestPublicAPI_MatchesCommittedSDK(t *testing.T) {
+	assertPublicAPIMatchesCode(t, filepath.Join("..", "..", "..", "src", "SumUp"))
+}
+
+// TestPublicAPI_MatchesGeneratedCode checks the snapshot against the code the
+// templates render, so template changes cannot silently skip the snapshot.
+func TestPublicAPI_MatchesGeneratedCode(t *testing.T) {
+	doc, err := spec.Load(t.Context(), filepath.Join("..", "..", "..", "openapi.json"), spec.LoadOptions{})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	output := t.TempDir()
+	if err := New(Config{OutputDir: output, Namespace: "SumUp"}).Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	assertPublicAPIMatchesCode(t, output)
+}
+
+// TestPublicAPI_MatchesGeneratedRecords checks the snapshot of record models.
+func TestPublicAPI_MatchesGeneratedRecords(t *testing.T) {
+	doc, err := spec.Load(t.Context(), filepath.Join("..", "..", "..", "openapi.json"), spec.LoadOptions{})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	output := t.TempDir()
+	if err := New(Config{OutputDir: output, Namespace: "SumUp", RecordModels: true}).Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	assertPublicAPIMatchesCode(t, output)
+}
+
+func assertPublicAPIMatchesCode(t *testing.T, dir string) {
+	t.Helper()
+
+	snapshot, err := os.ReadFile(filepath.Join(dir, publicAPIFile))
+	if err != nil {
+		t.Fatalf("read snapshot: %v", err)
+	}
+	want := strings.Split(strings.TrimSuffix(string(snapshot), "\n"), "\n")
+	if len(want) == 0 || want[0] != "#nullable enable" {
+		t.Fatalf("snapshot does not start with #nullable enable")
+	}
+	want = want[1:]
+	got := parsePublicAPI(t, dir)
+
+	wantSet := map[string]struct{}{}
+	for _, line := range want {
+		wantSet[line] = struct{}{}
+	}
+	gotSet := map[string]struct{}{}
+	for _, line := range got {
+		gotSet[line] = struct{}{}
+		if _, ok := wantSet[line]; !ok {
+			t.Errorf("generated code declares %q, which is missing from %s", line, publicAPIFile)
+		}
+	}
+	for _, line := range want {
+		if _, ok := gotSet[line]; !ok {
+			t.Errorf("%s lists %q, which the generated code does not declare", publicAPIFile, line)
+		}
+	}
+	if !sort.StringsAreSorted(want) {
+		t.Errorf("%s is not sorted", publicAPIFile)
+	}
+}
+
+// parsePublicAPI reads the public declarations of the generated .g.cs files
+// in dir, in the format of the snapshot.
+func parsePublicAPI(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var lines []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == "bin" || entry.Name() == "obj" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(entry.Name(), ".g.cs") {
+			return nil
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		var namespace, typeName, enumValue string
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if match := publicNamespacePattern.FindStringSubmatch(line); match != nil {
+				namespace = match[1]
+				continue
+			}
+			if match := publicTypePattern.FindStringSubmatch(line); match != nil {
+				typeName = namespace + "." + match[2]
+				declaration := typeName + " (" + match[1] + ")"
+				if match[3] != "" {
+					declaration += " : " + match[3]
+				}
+				lines = append(lines, declaration)
+				continue
+			}
+			if typeName == "" {
+				continue
+			}
+			if match := publicPropertyPattern.FindStringSubmatch(line); match != nil {
+				accessors := "{ get; " + match[5] + "; }"
+				if match[4] != "" {
+					accessors = "{ get; }"
+				}
+				if match[1] != "" {
+					accessors = "required " + accessors
+				}
+				lines = append(lines, typeName+"."+match[3]+" "+accessors+" -> "+match[2])
+				continue
+			}
+			if match := publicMethodPattern.FindStringSubmatch(line); match != nil {
+				lines = append(lines, typeName+"."+match[2]+"("+match[3]+") -> "+match[1])
+				continue
+			}
+			if match := enumMemberValuePattern.FindStringSubmatch(line); match != nil {
+				enumValue = match[1]
+				continue
+			}
+			if match := enumMemberPattern.FindStringSubmatch(line); match != nil {
+				lines = append(lines, typeName+"."+match[1]+` = "`+enumValue+`"`)
+			}
+		}
+		return scanner.Err()
+	})
+	if err != nil {
+		t.Fatalf("parse generated code in %s: %v", dir, err)
+	}
+	return lines
+}
@@ -0,0 +1,146 @@
+package generator
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// Suffixes of the input variants of models with readOnly or writeOnly
+// properties.
+const (
+	inputSuffixCreate = "Create"
+	inputSuffixUpdate = "Update" // PATCH request bodies
+)
+
+// splitsReadWrite reports whether request bodies use input variants of the
+// models, without their readOnly properties, while responses drop writeOnly
+// properties.
+func (g *Generator) splitsReadWrite() bool {
+	return g.config.ReadWriteModels
+}
+
+// writingInput marks the models resolved until the returned restore function
+// is called as request input.
+func (g *Generator) writingInput() func() {
+	previous := g.writing
+	g.writing = true
+	return func() { g.writing = previous }
+}
+
+// inputSuffix returns the suffix of the input variant taken by a request body
+// of the HTTP method.
+func inputSuffix(method string) string {
+	if strings.EqualFold(method, http.MethodPatch) {
+		return inputSuffixUpdate
+	}
+	return inputSuffixCreate
+}
+
+// skipsProperty reports whether the model being built leaves out the
+// property: readOnly properties of input and writeOnly properties of output.
+func (g *Generator) skipsProperty(property *base.Schema) bool {
+	if !g.splitsReadWrite() {
+		return false
+	}
+	if g.writing {
+		return schemaIsReadOnly(property)
+	}
+	return schemaIsWriteOnly(property)
+}
+
+// inputVariant returns the name of the input variant of the component object
+// schemaRef references, building it on first use, or an empty string when
+// the component has no readOnly or writeOnly property, directly or through
+// the schemas it contains, and so serves as input as is.
+func (g *Generator) inputVariant(schemaRef *base.SchemaProxy, suffix string) (string, error) {
+	if !g.splitsReadWrite() || schemaRef == nil || !schemaRef.IsReference() {
+		return "", nil
+	}
+	component := componentName(schemaRef.GetReference())
+	info, ok := g.schemaTypes[component]
+	if !ok || info.Kind != schemaKindObject {
+		return "", nil
+	}
+	if !g.hasReadWriteProperties(g.schemaFromProxy(info.Schema), map[*base.Schema]bool{}) {
+		return "", nil
+	}
+	return g.buildInputVariant(component, info, suffix)
+}
+
+// updateVariant returns the name of the partial update variant taken by the
+// PATCH request bodies of a component also used elsewhere, building it on
+// first use, or an empty string for other schemas.
+func (g *Generator) updateVariant(schemaRef *base.SchemaProxy) (string, error) {
+	if schemaRef == nil || !schemaRef.IsReference() {
+		return "", nil
+	}
+	component := componentName(schemaRef.GetReference())
+	if _, ok := g.updateVariants[component]; !ok {
+		return "", nil
+	}
+	info, ok := g.schemaTypes[component]
+	if !ok || info.Kind != schemaKindObject {
+		return "", nil
+	}
+	return g.buildInputVariant(component, info, inputSuffixUpdate)
+}
+
+func (g *Generator) buildInputVariant(component string, info *schemaTypeInfo, suffix string) (string, error) {
+	key := suffix + " " + component
+	if name, ok := g.inputVariants[key]; ok {
+		return name, nil
+	}
+	name := g.reserveModelName(info.TypeName + suffix)
+	g.inputVariants[key] = name
+
+	schema := g.schemaFromProxy(info.Schema)
+	defer g.at(schemaPointer(component))()
+	defer g.writingInput()()
+	model, err := g.buildClassModel(name, schema)
+	if err != nil {
+		return "", err
+	}
+	if suffix == inputSuffixUpdate && !g.isPartialUpdate(model.Pointer, schema) {
+		optionalProperties(model.Properties)
+	}
+	model.InputOf = info.TypeName
+	g.inlineModels = append(g.inlineModels, model)
+	return name, nil
+}
+
+// hasReadWriteProperties reports whether schema, or a schema it contains,
+// declares a readOnly or writeOnly property.
+func (g *Generator) hasReadWriteProperties(schema *base.Schema, seen map[*base.Schema]bool) bool {
+	if schema == nil || seen[schema] {
+		return false
+	}
+	seen[schema] = true
+	nested := make([]*base.SchemaProxy, 0)
+	if schema.Properties != nil {
+		for _, property := range schema.Properties.FromOldest() {
+			if resolved := g.schemaFromProxy(property); schemaIsReadOnly(resolved) || schemaIsWriteOnly(resolved) {
+				return true
+			}
+			nested = append(nested, property)
+		}
+	}
+	nested = append(nested, schema.AllOf...)
+	if schema.Items != nil && schema.Items.IsA() {
+		nested = append(nested, schema.Items.A)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		nested = append(nested, schema.AdditionalProperties.A)
+	}
+	for _, proxy := range nested {
+		if g.hasReadWriteProperties(g.schemaFromProxy(proxy), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func schemaIsWriteOnly(schema *base.Schema) bool {
+	return schema != nil && schema.WriteOnly != nil && *schema.WriteOnly
+}
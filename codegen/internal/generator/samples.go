@@ -1,15 +1,16 @@
 package generator
 
 import (
-	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
-	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
-	"go.yaml.in/yaml/v4"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/examples"
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
 )
 
 const sampleCatalogSchemaVersion = 1
@@ -57,23 +58,16 @@ func (g *Generator) Samples(doc *v3.Document, sdkVersion string) (*SampleCatalog
 		return nil, fmt.Errorf("sdk version is required")
 	}
 
-	g.inlineModels = nil
-	g.modelNames = map[string]struct{}{}
-	g.errorModels = map[string]struct{}{}
-	g.optionNames = map[string]struct{}{}
-	if _, err := g.buildModels(doc); err != nil {
-		return nil, fmt.Errorf("build models: %w", err)
-	}
-	clients, err := g.buildClients(doc)
+	built, err := g.build(doc)
 	if err != nil {
-		return nil, fmt.Errorf("build clients: %w", err)
+		return nil, fmt.Errorf("build sdk: %w", err)
 	}
 
 	samples := make([]Sample, 0)
-	for _, client := range clients {
+	for _, client := range built.clients {
 		for _, operation := range client.Operations {
 			if operation.OperationID == "" {
-				return nil, fmt.Errorf("missing operationId for %s %s", strings.ToUpper(operation.HttpMethod), operation.Path)
+				return nil, g.errorf(spec.Pointer("paths", operation.Path, strings.ToLower(operation.HttpMethod)), "missing operationId for %s %s", strings.ToUpper(operation.HttpMethod), operation.Path)
 			}
 			for _, example := range operation.RequestExamples {
 				id := operation.OperationID
@@ -103,11 +97,15 @@ func (g *Generator) Samples(doc *v3.Document, sdkVersion string) (*SampleCatalog
 	}
 	sort.Slice(samples, func(i, j int) bool { return samples[i].ID < samples[j].ID })
 
+	module := g.config.SampleModule
+	if module == "" {
+		module = "SumUp"
+	}
 	return &SampleCatalog{
 		SchemaVersion: sampleCatalogSchemaVersion,
 		Language:      "csharp",
 		SDK: SampleSDK{
-			Module:  "SumUp",
+			Module:  module,
 			Version: strings.TrimSpace(sdkVersion),
 		},
 		OpenAPIVersion: strings.TrimSpace(doc.Info.Version),
@@ -118,9 +116,26 @@ func (g *Generator) Samples(doc *v3.Document, sdkVersion string) (*SampleCatalog
 func (g *Generator) renderSample(client clientTemplateData, operation operationTemplateData, example requestExample) string {
 	arguments := make([]string, 0, len(operation.PathParams)+2)
 	for _, parameter := range operation.PathParams {
-		arguments = append(arguments, g.sampleValue(parameter.TypeName, parameter.Name))
+		arguments = append(arguments, g.parameterSample(parameter))
 	}
-	if operation.Body != nil {
+	switch {
+	case operation.Body != nil && operation.Body.PatchResource != "":
+		// Patch builders diff two copies of the resource; the modified copy
+		// sets the fields of object examples, as merge patches are. Both are
+		// read with the source-generated metadata the SDK itself uses.
+		payload := "{}"
+		if strings.HasPrefix(example.json, "{") {
+			payload = example.json
+		}
+		typeInfo := fmt.Sprintf("%s.Default.%s", jsonContextName, jsonContextProperty(operation.Body.PatchResource))
+		arguments = append(arguments, fmt.Sprintf(
+			"%s.From(\n    JsonSerializer.Deserialize(@\"{}\", %s)!,\n    JsonSerializer.Deserialize(@\"%s\", %s)!)",
+			strings.TrimSuffix(operation.Body.TypeName, "?"),
+			typeInfo,
+			strings.ReplaceAll(payload, "\"", "\"\""),
+			typeInfo,
+		))
+	case operation.Body != nil:
 		bodyType := strings.TrimSuffix(operation.Body.TypeName, "?")
 		payload := example.json
 		if payload == "" {
@@ -172,6 +187,27 @@ public static class Program
 `, call)
 }
 
+// parameterSample prefers the documented example of a path parameter over a
+// placeholder derived from its name.
+func (g *Generator) parameterSample(parameter parameterTemplateData) string {
+	example := parameter.Example
+	switch strings.TrimSuffix(parameter.TypeName, "?") {
+	case "string":
+		if example != "" {
+			return csharpString(example)
+		}
+	case "int":
+		if _, err := strconv.ParseInt(example, 10, 32); err == nil {
+			return example
+		}
+	case "long":
+		if _, err := strconv.ParseInt(example, 10, 64); err == nil {
+			return example + "L"
+		}
+	}
+	return g.sampleValue(parameter.TypeName, parameter.Name)
+}
+
 func (g *Generator) sampleValue(typeName, name string) string {
 	typeName = strings.TrimSuffix(strings.TrimSpace(typeName), "?")
 	if strings.HasPrefix(typeName, "OptionalQuery<") && strings.HasSuffix(typeName, ">") {
@@ -188,7 +224,7 @@ func (g *Generator) sampleValue(typeName, name string) string {
 
 	switch typeName {
 	case "string":
-		return fmt.Sprintf("%q", sampleString(name))
+		return csharpString(sampleString(name))
 	case "bool":
 		return "true"
 	case "byte[]":
@@ -265,35 +301,35 @@ func requestExamples(operation *v3.Operation) []requestExample {
 	if mediaType == nil {
 		return []requestExample{{}}
 	}
-	fallback := schemaSampleJSON(mediaType.Schema)
+	fallback := examples.JSON(mediaType.Schema, examples.Request)
 	if mediaType.Examples != nil && mediaType.Examples.Len() > 0 {
 		names := make([]string, 0, mediaType.Examples.Len())
 		for name := range mediaType.Examples.KeysFromOldest() {
 			names = append(names, name)
 		}
 		sort.Strings(names)
-		examples := make([]requestExample, 0, len(names))
+		result := make([]requestExample, 0, len(names))
 		for _, name := range names {
 			example := mediaType.Examples.GetOrZero(name)
 			if example == nil {
 				continue
 			}
-			value := nodeJSON(example.Value)
+			value := examples.NodeJSON(example.Value)
 			if value == "" {
 				value = fallback
 			}
-			examples = append(examples, requestExample{
+			result = append(result, requestExample{
 				name:        name,
 				summary:     example.Summary,
 				description: example.Description,
 				json:        value,
 			})
 		}
-		if len(examples) > 0 {
-			return examples
+		if len(result) > 0 {
+			return result
 		}
 	}
-	if value := nodeJSON(mediaType.Example); value != "" {
+	if value := examples.NodeJSON(mediaType.Example); value != "" {
 		return []requestExample{{json: value}}
 	}
 	return []requestExample{{json: fallback}}
@@ -304,8 +340,7 @@ func preferredSampleMediaType(content *orderedmap.Map[string, *v3.MediaType]) *v
 		return nil
 	}
 	for contentType, mediaType := range content.FromOldest() {
-		lower := strings.ToLower(contentType)
-		if lower == "application/json" || strings.HasSuffix(lower, "+json") {
+		if spec.IsJSON(contentType) {
 			return mediaType
 		}
 	}
@@ -314,112 +349,3 @@ func preferredSampleMediaType(content *orderedmap.Map[string, *v3.MediaType]) *v
 	}
 	return nil
 }
-
-func schemaSampleJSON(proxy *base.SchemaProxy) string {
-	value := schemaSampleValue(proxy, 0)
-	encoded, err := json.Marshal(value)
-	if err != nil {
-		return "{}"
-	}
-	return string(encoded)
-}
-
-func schemaSampleValue(proxy *base.SchemaProxy, depth int) any {
-	if proxy == nil || depth > 6 {
-		return map[string]any{}
-	}
-	schema := proxy.Schema()
-	if schema == nil {
-		return map[string]any{}
-	}
-	for _, node := range append(append([]*yaml.Node{}, schema.Examples...), schema.Example, schema.Default, schema.Const) {
-		if value, ok := decodeNode(node); ok {
-			return value
-		}
-	}
-	if len(schema.Enum) > 0 {
-		if value, ok := decodeNode(schema.Enum[0]); ok {
-			return value
-		}
-	}
-	if len(schema.AllOf) > 0 {
-		merged := map[string]any{}
-		for _, part := range schema.AllOf {
-			if value, ok := schemaSampleValue(part, depth+1).(map[string]any); ok {
-				for key, item := range value {
-					merged[key] = item
-				}
-			}
-		}
-		if len(merged) > 0 {
-			return merged
-		}
-	}
-	if len(schema.OneOf) > 0 {
-		return schemaSampleValue(schema.OneOf[0], depth+1)
-	}
-	if len(schema.AnyOf) > 0 {
-		return schemaSampleValue(schema.AnyOf[0], depth+1)
-	}
-	if schemaHasType(schema, "array") && schema.Items != nil && schema.Items.IsA() {
-		return []any{schemaSampleValue(schema.Items.A, depth+1)}
-	}
-	if schemaHasType(schema, "object") || (schema.Properties != nil && schema.Properties.Len() > 0) {
-		value := map[string]any{}
-		required := make(map[string]struct{}, len(schema.Required))
-		for _, name := range schema.Required {
-			required[name] = struct{}{}
-		}
-		if schema.Properties != nil {
-			for name, property := range schema.Properties.FromOldest() {
-				propertySchema := property.Schema()
-				if propertySchema != nil && propertySchema.ReadOnly != nil && *propertySchema.ReadOnly {
-					continue
-				}
-				if _, ok := required[name]; ok || schemaHasExample(propertySchema) {
-					value[name] = schemaSampleValue(property, depth+1)
-				}
-			}
-		}
-		return value
-	}
-	switch {
-	case schemaHasType(schema, "string"):
-		return "example"
-	case schemaHasType(schema, "integer"):
-		return 1
-	case schemaHasType(schema, "number"):
-		return 10.0
-	case schemaHasType(schema, "boolean"):
-		return true
-	default:
-		return map[string]any{}
-	}
-}
-
-func schemaHasExample(schema *base.Schema) bool {
-	return schema != nil && (schema.Example != nil || len(schema.Examples) > 0 || schema.Default != nil || schema.Const != nil || len(schema.Enum) > 0)
-}
-
-func nodeJSON(node *yaml.Node) string {
-	value, ok := decodeNode(node)
-	if !ok {
-		return ""
-	}
-	encoded, err := json.Marshal(value)
-	if err != nil {
-		return ""
-	}
-	return string(encoded)
-}
-
-func decodeNode(node *yaml.Node) (any, bool) {
-	if node == nil {
-		return nil, false
-	}
-	var value any
-	if err := node.Decode(&value); err != nil {
-		return nil, false
-	}
-	return value, true
-}
@@ -99,13 +99,52 @@ func TestRequestExamplesPreserveWholeRequestExample(t *testing.T) {
 	}
 }
 
+func TestSamplesApplyFilter(t *testing.T) {
+	doc, err := spec.Load(t.Context(), filepath.Join("..", "..", "..", "openapi.json"), spec.LoadOptions{})
+	if err != nil {
+		t.Fatalf("load OpenAPI document: %v", err)
+	}
+	filter := Filter{Include: Selector{Paths: []string{"/v0.1/merchants/{merchant_code}/readers"}}, Exclude: Selector{Operations: []string{"Delete*"}}}
+	catalog, err := New(Config{Namespace: "SumUp", Filter: filter}).Samples(doc, "test")
+	if err != nil {
+		t.Fatalf("generate samples: %v", err)
+	}
+	if len(catalog.Samples) == 0 {
+		t.Fatalf("filtered catalog is empty")
+	}
+	for _, sample := range catalog.Samples {
+		if !strings.HasPrefix(sample.Path, "/v0.1/merchants/{merchant_code}/readers") || strings.HasPrefix(sample.OperationID, "Delete") {
+			t.Errorf("sample %s (%s %s) does not pass the filter", sample.ID, sample.HTTPMethod, sample.Path)
+		}
+	}
+}
+
+func TestParameterSampleQuotesCSharpStrings(t *testing.T) {
+	g := New(Config{Namespace: "SumUp"})
+	tests := []struct {
+		example string
+		want    string
+	}{
+		{"MH4H92C7", `"MH4H92C7"`},
+		{"tab\there \"quoted\"", `"tab\there \"quoted\""`},
+		// Go would write \x7fA, which C# reads as the single character U+7FA.
+		{"\x7fA", `"\u007fA"`},
+		{"line\u2028break", `"line\u2028break"`},
+	}
+	for _, tt := range tests {
+		if got := g.parameterSample(parameterTemplateData{Name: "merchant_code", TypeName: "string", Example: tt.example}); got != tt.want {
+			t.Errorf("parameterSample(%q) = %s, want %s", tt.example, got, tt.want)
+		}
+	}
+}
+
 func testSampleCatalog(t *testing.T) (string, *SampleCatalog) {
 	t.Helper()
 	repositoryRoot, err := filepath.Abs(filepath.Join("..", "..", ".."))
 	if err != nil {
 		t.Fatalf("resolve repository root: %v", err)
 	}
-	doc, err := spec.Load(t.Context(), filepath.Join(repositoryRoot, "openapi.json"))
+	doc, err := spec.Load(t.Context(), filepath.Join(repositoryRoot, "openapi.json"), spec.LoadOptions{})
 	if err != nil {
 		t.Fatalf("load OpenAPI document: %v", err)
 	}
@@ -164,3 +203,18 @@ func compileSamples(t *testing.T, repositoryRoot string, samples []Sample) {
 		t.Fatalf("compile generated samples: %v\n%s", err, output)
 	}
 }
+
+func TestSamplesReadPatchResourcesWithSourceGeneratedMetadata(t *testing.T) {
+	catalog, err := New(Config{Namespace: "SumUp"}).Samples(mustBuildV3Document(t, patchSpec), "test")
+	if err != nil {
+		t.Fatalf("generate samples: %v", err)
+	}
+
+	sample := sampleByID(t, catalog.Samples, "UpdateReader")
+	if !strings.Contains(sample.Source, `JsonSerializer.Deserialize(@"{}", SumUpJsonContext.Default.Reader)!`) {
+		t.Fatalf("UpdateReader sample does not read the resource through SumUpJsonContext:\n%s", sample.Source)
+	}
+	if strings.Contains(sample.Source, "Deserialize<") {
+		t.Fatalf("UpdateReader sample deserializes with reflection:\n%s", sample.Source)
+	}
+}
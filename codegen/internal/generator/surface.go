@@ -0,0 +1,166 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// Surface is the public C# API generated for a document: the clients and
+// their methods, and the models, enums and options classes they use. It is
+// what SDK consumers compile against, so comparing two surfaces tells whether
+// a spec update breaks them.
+type Surface struct {
+	Clients []SurfaceClient `json:"clients"`
+	Types   []SurfaceType   `json:"types"`
+}
+
+// SurfaceClient is a generated client class.
+type SurfaceClient struct {
+	Name string `json:"name"`
+	// Property is the SumUpClient property exposing the client.
+	Property string          `json:"property"`
+	Methods  []SurfaceMethod `json:"methods"`
+}
+
+// SurfaceMethod is a generated client method. Each method is emitted in a
+// synchronous and an Async flavour sharing the same parameters.
+type SurfaceMethod struct {
+	Name        string             `json:"name"`
+	OperationID string             `json:"operationId,omitempty"`
+	HTTPMethod  string             `json:"httpMethod"`
+	Path        string             `json:"path"`
+	ReturnType  string             `json:"returnType"`
+	Parameters  []SurfaceParameter `json:"parameters"`
+	Deprecated  bool               `json:"deprecated,omitempty"`
+}
+
+// SurfaceParameter is a positional method parameter. Optional parameters have
+// a default value and may be omitted by callers.
+type SurfaceParameter struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// SurfaceType is a generated model, enum or operation options class.
+type SurfaceType struct {
+	Name string `json:"name"`
+	// Kind is "class", "enum", "dictionary" or "options".
+	Kind       string            `json:"kind"`
+	BaseType   string            `json:"baseType,omitempty"`
+	Properties []SurfaceProperty `json:"properties,omitempty"`
+	Members    []string          `json:"members,omitempty"`
+	Deprecated bool              `json:"deprecated,omitempty"`
+}
+
+// SurfaceProperty is a property of a generated class. Required reflects the
+// spec, which decides whether the C# type is nullable.
+type SurfaceProperty struct {
+	Name       string `json:"name"`
+	JSONName   string `json:"jsonName,omitempty"`
+	Type       string `json:"type"`
+	Required   bool   `json:"required,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
+
+// Surface builds the generator model for doc without writing any files and
+// returns its public C# API, with clients, methods and types sorted by name.
+func (g *Generator) Surface(doc *v3.Document) (*Surface, error) {
+	built, err := g.build(doc)
+	if err != nil {
+		return nil, fmt.Errorf("build sdk: %w", err)
+	}
+
+	surface := &Surface{}
+	for _, client := range built.clients {
+		surfaceClient := SurfaceClient{Name: client.ClientName + "Client", Property: client.PropertyName}
+		for _, operation := range client.Operations {
+			method := SurfaceMethod{
+				Name:        operation.MethodName,
+				OperationID: operation.OperationID,
+				HTTPMethod:  strings.ToUpper(operation.HttpMethod),
+				Path:        operation.Path,
+				ReturnType:  fmt.Sprintf("ApiResponse<%s>", operation.ResponseType),
+				Deprecated:  operation.Deprecated,
+			}
+			for _, parameter := range operation.Parameters {
+				method.Parameters = append(method.Parameters, surfaceParameter(parameter))
+			}
+			surfaceClient.Methods = append(surfaceClient.Methods, method)
+		}
+		sort.Slice(surfaceClient.Methods, func(i, j int) bool {
+			return surfaceClient.Methods[i].Name < surfaceClient.Methods[j].Name
+		})
+		surface.Clients = append(surface.Clients, surfaceClient)
+	}
+
+	for _, model := range built.models {
+		surface.Types = append(surface.Types, surfaceType(model))
+	}
+	for _, options := range built.options {
+		surfaceOptions := SurfaceType{Name: options.Name, Kind: "options"}
+		for _, property := range options.Properties {
+			surfaceOptions.Properties = append(surfaceOptions.Properties, SurfaceProperty{
+				Name:     property.PropertyName,
+				Type:     property.TypeName,
+				Required: property.Required,
+			})
+		}
+		surface.Types = append(surface.Types, surfaceOptions)
+	}
+
+	sort.Slice(surface.Clients, func(i, j int) bool { return surface.Clients[i].Name < surface.Clients[j].Name })
+	sort.Slice(surface.Types, func(i, j int) bool { return surface.Types[i].Name < surface.Types[j].Name })
+	return surface, nil
+}
+
+func surfaceType(model modelTemplateData) SurfaceType {
+	if model.Kind == schemaKindEnum {
+		values := make([]string, 0, len(model.EnumValues))
+		for _, value := range model.EnumValues {
+			values = append(values, value.Name)
+		}
+		return SurfaceType{Name: model.Name, Kind: "enum", Members: values, Deprecated: model.Deprecated}
+	}
+	if model.IsDictionaryModel {
+		baseType := model.DictionaryBaseType
+		if baseType == "" {
+			baseType = fmt.Sprintf("Dictionary<string, %s>", model.DictionaryValueType)
+		}
+		return SurfaceType{Name: model.Name, Kind: "dictionary", BaseType: baseType, Deprecated: model.Deprecated}
+	}
+	// Inherited properties are part of the surface of derived classes, so
+	// moving a property to a base class does not remove it.
+	surfaceModel := SurfaceType{Name: model.Name, Kind: "class", BaseType: model.BaseType, Deprecated: model.Deprecated}
+	for _, property := range append(append([]modelPropertyTemplateData(nil), model.InheritedProperties...), model.Properties...) {
+		surfaceModel.Properties = append(surfaceModel.Properties, SurfaceProperty{
+			Name:       property.PropertyName,
+			JSONName:   property.JsonName,
+			Type:       property.TypeName,
+			Required:   property.Required,
+			Deprecated: property.Deprecated,
+		})
+	}
+	if model.HasExtensionData {
+		surfaceModel.Properties = append(surfaceModel.Properties, SurfaceProperty{
+			Name: "AdditionalProperties",
+			Type: fmt.Sprintf("IDictionary<string, %s>", model.ExtensionDataValueType),
+		})
+	}
+	return surfaceModel
+}
+
+// surfaceParameter splits a C# parameter declaration such as
+// "CheckoutsListOptions? options = null" into its type and name.
+func surfaceParameter(parameter methodParameter) SurfaceParameter {
+	declaration, _, optional := strings.Cut(parameter.Signature, " = ")
+	declaration = strings.TrimSpace(declaration)
+	typeName := declaration
+	if index := strings.LastIndex(declaration, " "); index >= 0 {
+		typeName = declaration[:index]
+	}
+	return SurfaceParameter{Name: parameter.Name, Type: typeName, Optional: optional}
+}
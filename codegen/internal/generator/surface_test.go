@@ -0,0 +1,49 @@
+package generator
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+)
+
+func TestSurface_DescribesGeneratedSignatures(t *testing.T) {
+	doc, err := spec.Load(t.Context(), filepath.Join("testdata", "openapi-3.1.json"), spec.LoadOptions{})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	surface, err := New(Config{Namespace: "SumUp"}).Surface(doc)
+	if err != nil {
+		t.Fatalf("Surface() error = %v", err)
+	}
+
+	if len(surface.Clients) != 1 || surface.Clients[0].Name != "PetsClient" {
+		t.Fatalf("Surface().Clients = %+v, want PetsClient", surface.Clients)
+	}
+	get := surface.Clients[0].Methods[1]
+	want := SurfaceMethod{
+		Name:        "Get",
+		OperationID: "GetPet",
+		HTTPMethod:  "GET",
+		Path:        "/v1/pets/{pet_id}",
+		ReturnType:  "ApiResponse<Pet?>",
+		Parameters: []SurfaceParameter{
+			{Name: "petId", Type: "string"},
+			{Name: "options", Type: "PetsGetOptions?", Optional: true},
+		},
+	}
+	if !reflect.DeepEqual(get, want) {
+		t.Fatalf("Get = %+v, want %+v", get, want)
+	}
+
+	kinds := map[string]string{}
+	for _, surfaceType := range surface.Types {
+		kinds[surfaceType.Name] = surfaceType.Kind
+	}
+	for name, kind := range map[string]string{"Pet": "class", "PetKind": "enum", "PetMicrochip": "class", "PetsGetOptions": "options"} {
+		if kinds[name] != kind {
+			t.Errorf("kind of %s = %q, want %q", name, kinds[name], kind)
+		}
+	}
+}
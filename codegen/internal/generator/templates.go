@@ -0,0 +1,178 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/naming"
+)
+
+// TemplateDataVersion is the version of the data and functions templates
+// receive, documented in TEMPLATES.md. It is bumped whenever a field or
+// function is removed or changes meaning; additions keep the version.
+const TemplateDataVersion = 2
+
+// templateVersionFile records the TemplateDataVersion a templates directory
+// was written against.
+const templateVersionFile = "VERSION"
+
+// templateFuncs are the functions available to every template.
+var templateFuncs = template.FuncMap{
+	"pascal":              naming.PascalIdentifier,
+	"camel":               naming.Identifier,
+	"doc":                 sanitizeText,
+	"xmlEscape":           xmlEscape,
+	"csharpString":        csharpString,
+	"nonNullable":         func(typeName string) string { return strings.TrimSuffix(typeName, "?") },
+	"lower":               strings.ToLower,
+	"upper":               strings.ToUpper,
+	"join":                func(separator string, values []string) string { return strings.Join(values, separator) },
+	"hasPrefix":           strings.HasPrefix,
+	"hasSuffix":           strings.HasSuffix,
+	"trimPrefix":          func(prefix, value string) string { return strings.TrimPrefix(value, prefix) },
+	"trimSuffix":          func(suffix, value string) string { return strings.TrimSuffix(value, suffix) },
+	"replace":             func(old, new, value string) string { return strings.ReplaceAll(value, old, new) },
+	"sentence":            sentence,
+	"templateDataVersion": func() int { return TemplateDataVersion },
+}
+
+// sentence terminates value with a period unless it already ends with
+// punctuation, so further text can follow it.
+func sentence(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.ContainsAny(value[len(value)-1:], ".!?:") {
+		return value
+	}
+	return value + "."
+}
+
+// parseTemplates parses the embedded templates, replaced by those of
+// TemplatesDir with the same file name.
+func (g *Generator) parseTemplates() (*template.Template, error) {
+	tmpl, err := template.New("clients").Funcs(templateFuncs).ParseFS(templateFS, "templates/*.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("parse templates: %w", err)
+	}
+	if g.config.TemplatesDir == "" {
+		return tmpl, nil
+	}
+	if err := checkTemplateVersion(g.config.TemplatesDir); err != nil {
+		return nil, err
+	}
+	overrides, err := filepath.Glob(filepath.Join(g.config.TemplatesDir, "*.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("list templates: %w", err)
+	}
+	if len(overrides) == 0 {
+		return tmpl, nil
+	}
+	if tmpl, err = tmpl.ParseFiles(overrides...); err != nil {
+		return nil, fmt.Errorf("parse templates: %w", err)
+	}
+	return tmpl, nil
+}
+
+// checkTemplateVersion fails when dir was exported for another
+// TemplateDataVersion. Directories without a VERSION file are not checked.
+func checkTemplateVersion(dir string) error {
+	if _, err := os.Stat(dir); err != nil {
+		return fmt.Errorf("templates directory: %w", err)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, templateVersionFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read template version: %w", err)
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return fmt.Errorf("%s: invalid template data version %q", filepath.Join(dir, templateVersionFile), strings.TrimSpace(string(content)))
+	}
+	if version != TemplateDataVersion {
+		return fmt.Errorf("templates in %s target template data version %d, but this codegen provides version %d; compare them with the output of `codegen templates export`", dir, version, TemplateDataVersion)
+	}
+	return nil
+}
+
+// ExportTemplates writes the embedded templates and a VERSION file to dir, as
+// a starting point for TemplatesDir. Existing files are only replaced when
+// overwrite is set. It returns the paths written.
+func ExportTemplates(dir string, overwrite bool) ([]string, error) {
+	entries, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("list templates: %w", err)
+	}
+	files := map[string][]byte{templateVersionFile: []byte(strconv.Itoa(TemplateDataVersion) + "\n")}
+	names := []string{templateVersionFile}
+	for _, entry := range entries {
+		content, err := fs.ReadFile(templateFS, "templates/"+entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read template %s: %w", entry.Name(), err)
+		}
+		files[entry.Name()] = content
+		names = append(names, entry.Name())
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create templates directory: %w", err)
+	}
+	if !overwrite {
+		for _, name := range names {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				return nil, fmt.Errorf("%s already exists (pass --force to overwrite)", filepath.Join(dir, name))
+			}
+		}
+	}
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, files[name], 0o644); err != nil {
+			return nil, fmt.Errorf("write %s: %w", path, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func xmlEscape(value string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(value)
+}
+
+// csharpString quotes value as a C# regular string literal.
+func csharpString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case 0:
+			b.WriteString(`\0`)
+		default:
+			// Control characters, and the line terminators a regular
+			// literal cannot contain, use Unicode escapes.
+			if r < 0x20 || r == 0x7f || r == 0x85 || r == 0x2028 || r == 0x2029 {
+				fmt.Fprintf(&b, `\u%04x`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTemplatesDocumentEveryTemplateDataField(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("..", "..", "TEMPLATES.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := string(content)
+	if !strings.Contains(doc, "version **"+strconv.Itoa(TemplateDataVersion)+"**") {
+		t.Errorf("TEMPLATES.md does not describe template data version %d", TemplateDataVersion)
+	}
+
+	for _, data := range []any{
+		apiVersionTemplateData{}, rootTemplateData{}, clientTemplateData{}, operationTemplateData{},
+		parameterTemplateData{}, methodParameter{}, bodyTemplateData{}, errorResponseTemplateData{},
+		optionsTemplateData{}, optionsPropertyTemplateData{}, modelTemplateData{}, modelPropertyTemplateData{},
+		enumValueTemplateData{}, fakeRootTemplateData{}, fakeClientTemplateData{}, fakeRouteTemplateData{},
+		fakeRouteParameterTemplateData{}, jsonContextTemplateData{}, jsonContextTypeTemplateData{},
+	} {
+		typ := reflect.TypeOf(data)
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if field.Name == "RequestExamples" {
+				continue
+			}
+			if !strings.Contains(doc, "`"+field.Name+"`") {
+				t.Errorf("TEMPLATES.md does not document %s.%s", typ.Name(), field.Name)
+			}
+		}
+	}
+	for name := range templateFuncs {
+		if !strings.Contains(doc, "`"+name) {
+			t.Errorf("TEMPLATES.md does not document the %s function", name)
+		}
+	}
+}
+
+func TestExportTemplates(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "templates")
+
+	paths, err := ExportTemplates(dir, false)
+	if err != nil {
+		t.Fatalf("ExportTemplates() error = %v", err)
+	}
+	templates, err := fs.Glob(templateFS, "templates/*.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != len(templates)+1 || paths[0] != filepath.Join(dir, templateVersionFile) {
+		t.Fatalf("ExportTemplates() = %v, want VERSION and the %d templates", paths, len(templates))
+	}
+	if err := checkTemplateVersion(dir); err != nil {
+		t.Fatalf("checkTemplateVersion() error = %v", err)
+	}
+	exported, err := os.ReadFile(filepath.Join(dir, "client.tmpl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	embedded, err := templateFS.ReadFile("templates/client.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(exported) != string(embedded) {
+		t.Fatal("exported client.tmpl differs from the embedded template")
+	}
+
+	if _, err := ExportTemplates(dir, false); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("ExportTemplates() error = %v, want an already exists error", err)
+	}
+	if _, err := ExportTemplates(dir, true); err != nil {
+		t.Fatalf("ExportTemplates(overwrite) error = %v", err)
+	}
+}
+
+func TestRun_RejectsTemplatesOfAnotherDataVersion(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+	templates := t.TempDir()
+	if err := os.WriteFile(filepath.Join(templates, templateVersionFile), []byte("0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	output := t.TempDir()
+	previous := filepath.Join(output, "SumUpClient.g.cs")
+	if err := os.WriteFile(previous, []byte("// previous"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := New(Config{OutputDir: output, Namespace: "SumUp", TemplatesDir: templates}).Run(doc)
+	if err == nil || !strings.Contains(err.Error(), "template data version 0") {
+		t.Fatalf("Run() error = %v, want a template data version mismatch", err)
+	}
+	if _, err := os.Stat(previous); err != nil {
+		t.Fatalf("previous output removed: %v", err)
+	}
+}
+
+func TestRun_TemplatesUseFunctionMap(t *testing.T) {
+	doc := mustBuildV3Document(t, configuredSpec)
+	templates := t.TempDir()
+	override := `{{ csharpString (printf "v%s \"%s\"" .ApiVersion (upper .Namespace)) }} {{ pascal "api_version" }} {{ templateDataVersion }}`
+	if err := os.WriteFile(filepath.Join(templates, "api_version.tmpl"), []byte(override), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	output := t.TempDir()
+
+	if err := New(Config{OutputDir: output, Namespace: "SumUp", TemplatesDir: templates, SkipPublicAPI: true}).Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	version, err := os.ReadFile(filepath.Join(output, "Http", "ApiVersion.g.cs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"v1.0.0 \"SUMUP\"" ApiVersion ` + strconv.Itoa(TemplateDataVersion); string(version) != want {
+		t.Fatalf("ApiVersion.g.cs = %q, want %q", version, want)
+	}
+}
@@ -0,0 +1,6 @@
+namespace SumUp;
+
+internal static class ApiVersionInfo
+{
+    internal const string Value = "2.0.0";
+}
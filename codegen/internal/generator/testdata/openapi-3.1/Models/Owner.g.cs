@@ -0,0 +1,13 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System.Text.Json.Serialization;
+public sealed partial class Owner
+{
+    [JsonPropertyName("email")]
+    public string? Email { get; set; }
+    [JsonPropertyName("name")]
+    public string? Name { get; set; }
+}
\ No newline at end of file
@@ -0,0 +1,28 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System.Text.Json.Serialization;
+using System.Collections.Generic;
+public sealed partial class Pet
+{
+    [JsonPropertyName("id")]
+    public long Id { get; set; }
+    [JsonPropertyName("kind")]
+    public PetKind Kind { get; set; }
+    [JsonPropertyName("legs")]
+    public int? Legs { get; set; }
+    [JsonPropertyName("location")]
+    public IEnumerable<double>? Location { get; set; }
+    [JsonPropertyName("microchip")]
+    public PetMicrochip? Microchip { get; set; }
+    [JsonPropertyName("name")]
+    public string? Name { get; set; }
+    [JsonPropertyName("owner")]
+    public Owner? Owner { get; set; }
+    [JsonPropertyName("status")]
+    public Status? Status { get; set; }
+    [JsonPropertyName("tag")]
+    public Tag? Tag { get; set; }
+}
\ No newline at end of file
@@ -0,0 +1,26 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System.Collections.Generic;
+using System.Runtime.Serialization;
+using System.Text.Json.Serialization;
+
+[JsonConverter(typeof(PetKindJsonConverter))]
+public enum PetKind
+{
+    [EnumMember(Value = "dog")]
+    Dog,
+}
+
+internal sealed class PetKindJsonConverter : EnumJsonConverter<PetKind>
+{
+    public PetKindJsonConverter()
+        : base(new Dictionary<PetKind, string>
+        {
+            [PetKind.Dog] = "dog",
+        })
+    {
+    }
+}
\ No newline at end of file
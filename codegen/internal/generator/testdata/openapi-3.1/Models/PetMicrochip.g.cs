@@ -0,0 +1,13 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System.Text.Json.Serialization;
+public sealed partial class PetMicrochip
+{
+    [JsonPropertyName("code")]
+    public string? Code { get; set; }
+    [JsonPropertyName("country")]
+    public string? Country { get; set; }
+}
\ No newline at end of file
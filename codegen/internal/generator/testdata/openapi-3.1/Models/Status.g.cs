@@ -0,0 +1,26 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System.Collections.Generic;
+using System.Runtime.Serialization;
+using System.Text.Json.Serialization;
+
+[JsonConverter(typeof(StatusJsonConverter))]
+public enum Status
+{
+    [EnumMember(Value = "active")]
+    Active,
+}
+
+internal sealed class StatusJsonConverter : EnumJsonConverter<Status>
+{
+    public StatusJsonConverter()
+        : base(new Dictionary<Status, string>
+        {
+            [Status.Active] = "active",
+        })
+    {
+    }
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System.Text.Json.Serialization;
+public sealed partial class Tag
+{
+    [JsonPropertyName("label")]
+    public string? Label { get; set; }
+}
\ No newline at end of file
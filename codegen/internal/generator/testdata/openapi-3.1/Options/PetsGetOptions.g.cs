@@ -0,0 +1,12 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+/// <summary>
+/// Optional parameters for Retrieve a pet.
+/// </summary>
+public sealed partial class PetsGetOptions
+{
+    public OptionalQuery<string> Include { get; set; }
+}
\ No newline at end of file
@@ -0,0 +1,192 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System;
+using System.Net.Http;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using SumUp.Http;
+
+public sealed partial class PetsClient
+{
+    private readonly ApiClient _client;
+
+    /// <summary>
+    /// Client for the Pets API endpoints.
+    /// </summary>
+    internal PetsClient(ApiClient client)
+    {
+        _client = client;
+    }
+
+    /// <summary>
+    /// Create a pet
+    /// </summary>
+    /// <param name="body">Request body payload.</param>
+    /// <param name="requestOptions">Optional per-request overrides.</param>
+    /// <param name="cancellationToken">Token used to cancel the request.</param>
+    public ApiResponse<Pet> Create(Pet body, RequestOptions? requestOptions = null, CancellationToken cancellationToken = default)
+    {
+        var request = _client.CreateRequest(HttpMethod.Post, "/v1/pets");
+        var effectiveCancellationToken = ApiClient.CreateCancellationToken(cancellationToken, requestOptions, out var timeoutScope);
+        try
+        {
+            _client.ApplyAuthorizationHeaderAsync(request, effectiveCancellationToken, requestOptions).GetAwaiter().GetResult();
+            if (body is not null && request.Content is null)
+            {
+                request.Content = _client.CreateContent(body, "application/json");
+            }
+
+            using var response = _client.HttpClient.SendAsync(
+                request,
+                HttpCompletionOption.ResponseHeadersRead,
+                effectiveCancellationToken).GetAwaiter().GetResult();
+
+            if (!response.IsSuccessStatusCode)
+            {
+                var responseBody = response.Content is null
+                    ? null
+                    : ApiClient.ReadContentAsStringAsync(response.Content, effectiveCancellationToken).GetAwaiter().GetResult();
+                var fallbackError = _client.TryDeserialize<ApiError>(responseBody);
+                throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
+            }
+            using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Pet>());
+            return ApiResponse<Pet>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
+        }
+        finally
+        {
+            timeoutScope?.Dispose();
+        }
+    }
+
+    /// <summary>
+    /// Create a pet
+    /// </summary>
+    /// <param name="body">Request body payload.</param>
+    /// <param name="requestOptions">Optional per-request overrides.</param>
+    /// <param name="cancellationToken">Token used to cancel the request.</param>
+    public async Task<ApiResponse<Pet>> CreateAsync(Pet body, RequestOptions? requestOptions = null, CancellationToken cancellationToken = default)
+    {
+        var request = _client.CreateRequest(HttpMethod.Post, "/v1/pets");
+        var effectiveCancellationToken = ApiClient.CreateCancellationToken(cancellationToken, requestOptions, out var timeoutScope);
+        try
+        {
+            await _client.ApplyAuthorizationHeaderAsync(request, effectiveCancellationToken, requestOptions).ConfigureAwait(false);
+            if (body is not null && request.Content is null)
+            {
+                request.Content = _client.CreateContent(body, "application/json");
+            }
+
+            using var response = await _client.HttpClient.SendAsync(
+                request,
+                HttpCompletionOption.ResponseHeadersRead,
+                effectiveCancellationToken).ConfigureAwait(false);
+
+            if (!response.IsSuccessStatusCode)
+            {
+                var responseBody = response.Content is null
+                    ? null
+                    : await ApiClient.ReadContentAsStringAsync(response.Content, effectiveCancellationToken).ConfigureAwait(false);
+                var fallbackError = _client.TryDeserialize<ApiError>(responseBody);
+                throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
+            }
+            using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Pet>(), effectiveCancellationToken).ConfigureAwait(false);
+            return ApiResponse<Pet>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
+        }
+        finally
+        {
+            timeoutScope?.Dispose();
+        }
+    }
+
+    /// <summary>
+    /// Retrieve a pet
+    /// </summary>
+    /// <param name="petId">Request parameter.</param>
+    /// <param name="options">Query and header parameters for the request.</param>
+    /// <param name="requestOptions">Optional per-request overrides.</param>
+    /// <param name="cancellationToken">Token used to cancel the request.</param>
+    public ApiResponse<Pet?> Get(string petId, PetsGetOptions? options = null, RequestOptions? requestOptions = null, CancellationToken cancellationToken = default)
+    {
+        var operationOptions = options ?? new PetsGetOptions();
+        var request = _client.CreateRequest(HttpMethod.Get, "/v1/pets/{pet_id}", builder =>
+        {
+            builder.AddPath("pet_id", petId);
+            builder.AddQuery("include", operationOptions.Include);
+        });
+        var effectiveCancellationToken = ApiClient.CreateCancellationToken(cancellationToken, requestOptions, out var timeoutScope);
+        try
+        {
+            _client.ApplyAuthorizationHeaderAsync(request, effectiveCancellationToken, requestOptions).GetAwaiter().GetResult();
+
+            using var response = _client.HttpClient.SendAsync(
+                request,
+                HttpCompletionOption.ResponseHeadersRead,
+                effectiveCancellationToken).GetAwaiter().GetResult();
+
+            if (!response.IsSuccessStatusCode)
+            {
+                var responseBody = response.Content is null
+                    ? null
+                    : ApiClient.ReadContentAsStringAsync(response.Content, effectiveCancellationToken).GetAwaiter().GetResult();
+                var fallbackError = _client.TryDeserialize<ApiError>(responseBody);
+                throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
+            }
+            using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Pet?>());
+            return ApiResponse<Pet?>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
+        }
+        finally
+        {
+            timeoutScope?.Dispose();
+        }
+    }
+
+    /// <summary>
+    /// Retrieve a pet
+    /// </summary>
+    /// <param name="petId">Request parameter.</param>
+    /// <param name="options">Query and header parameters for the request.</param>
+    /// <param name="requestOptions">Optional per-request overrides.</param>
+    /// <param name="cancellationToken">Token used to cancel the request.</param>
+    public async Task<ApiResponse<Pet?>> GetAsync(string petId, PetsGetOptions? options = null, RequestOptions? requestOptions = null, CancellationToken cancellationToken = default)
+    {
+        var operationOptions = options ?? new PetsGetOptions();
+        var request = _client.CreateRequest(HttpMethod.Get, "/v1/pets/{pet_id}", builder =>
+        {
+            builder.AddPath("pet_id", petId);
+            builder.AddQuery("include", operationOptions.Include);
+        });
+        var effectiveCancellationToken = ApiClient.CreateCancellationToken(cancellationToken, requestOptions, out var timeoutScope);
+        try
+        {
+            await _client.ApplyAuthorizationHeaderAsync(request, effectiveCancellationToken, requestOptions).ConfigureAwait(false);
+
+            using var response = await _client.HttpClient.SendAsync(
+                request,
+                HttpCompletionOption.ResponseHeadersRead,
+                effectiveCancellationToken).ConfigureAwait(false);
+
+            if (!response.IsSuccessStatusCode)
+            {
+                var responseBody = response.Content is null
+                    ? null
+                    : await ApiClient.ReadContentAsStringAsync(response.Content, effectiveCancellationToken).ConfigureAwait(false);
+                var fallbackError = _client.TryDeserialize<ApiError>(responseBody);
+                throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
+            }
+            using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Pet?>(), effectiveCancellationToken).ConfigureAwait(false);
+            return ApiResponse<Pet?>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
+        }
+        finally
+        {
+            timeoutScope?.Dispose();
+        }
+    }
+}
\ No newline at end of file
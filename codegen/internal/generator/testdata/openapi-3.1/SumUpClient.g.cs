@@ -0,0 +1,19 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using SumUp.Http;
+
+public partial class SumUpClient
+{
+    partial void InitializeGeneratedClients(ApiClient apiClient)
+    {
+        Pets = new PetsClient(apiClient);
+    }
+
+    /// <summary>
+    /// Access the Pets API endpoints.
+    /// </summary>
+    public PetsClient Pets { get; private set; } = default!;
+}
\ No newline at end of file
@@ -0,0 +1,26 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+/// <summary>
+/// Source-generated serialization metadata of the models, request bodies, responses and errors of the SDK, so
+/// clients serialize without reflection when trimmed or compiled with NativeAOT.
+/// </summary>
+[JsonSourceGenerationOptions(JsonSerializerDefaults.Web, PropertyNameCaseInsensitive = true)]
+[JsonSerializable(typeof(global::SumUp.Http.ApiError))]
+[JsonSerializable(typeof(IReadOnlyList<global::SumUp.JsonPatchOperation>))]
+[JsonSerializable(typeof(global::SumUp.JsonObject))]
+[JsonSerializable(typeof(Owner))]
+[JsonSerializable(typeof(Pet))]
+[JsonSerializable(typeof(PetKind))]
+[JsonSerializable(typeof(PetMicrochip))]
+[JsonSerializable(typeof(Status))]
+[JsonSerializable(typeof(Tag))]
+public partial class SumUpJsonContext : JsonSerializerContext
+{
+}
\ No newline at end of file
@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// fakeRootTemplateData is the data of fake_root.tmpl.
+type fakeRootTemplateData struct {
+	Namespace string
+	Clients   []fakeClientTemplateData
+}
+
+// fakeClientTemplateData is the data of fake_client.tmpl.
+type fakeClientTemplateData struct {
+	Namespace    string
+	ClientName   string
+	PropertyName string
+	Routes       []fakeRouteTemplateData
+}
+
+// fakeRouteTemplateData is a route of a fake client.
+type fakeRouteTemplateData struct {
+	MethodName     string
+	Summary        string
+	HttpMethodExpr string
+	Path           string
+	ResponseType   string
+	ContentType    string
+	PathParams     []fakeRouteParameterTemplateData
+}
+
+// fakeRouteParameterTemplateData is a path parameter of a fake route.
+type fakeRouteParameterTemplateData struct {
+	Name        string
+	ArgName     string
+	Declaration string
+	Description string
+}
+
+// buildFakeClients maps the generated clients onto the route tables of the
+// SumUp.Testing fake handler.
+func buildFakeClients(namespace string, clients []clientTemplateData) []fakeClientTemplateData {
+	fakes := make([]fakeClientTemplateData, 0, len(clients))
+	for _, client := range clients {
+		fake := fakeClientTemplateData{
+			Namespace:    namespace,
+			ClientName:   client.ClientName,
+			PropertyName: client.PropertyName,
+		}
+		for _, operation := range client.Operations {
+			route := fakeRouteTemplateData{
+				MethodName:     operation.MethodName,
+				Summary:        operation.Summary,
+				HttpMethodExpr: operation.HttpMethodExpr,
+				Path:           operation.Path,
+				ResponseType:   operation.ResponseType,
+				ContentType:    fakeContentType(operation.ResponseMode),
+			}
+			for _, param := range operation.PathParams {
+				route.PathParams = append(route.PathParams, fakeRouteParameterTemplateData{
+					Name:        param.Name,
+					ArgName:     param.ArgName,
+					Declaration: fmt.Sprintf("%s? %s = null", strings.TrimSuffix(param.TypeName, "?"), param.ArgName),
+					Description: param.Description,
+				})
+			}
+			fake.Routes = append(fake.Routes, route)
+		}
+		fakes = append(fakes, fake)
+	}
+	return fakes
+}
+
+func fakeContentType(responseMode string) string {
+	if responseMode == "string" {
+		return "text/plain"
+	}
+	return "application/json"
+}
+
+func (g *Generator) renderTesting(t *template.Template, clients []clientTemplateData) error {
+	fakes := buildFakeClients(g.config.Namespace, clients)
+	for _, fake := range fakes {
+		filePath := filepath.Join(g.config.TestingOutputDir, fmt.Sprintf("Fake%sRoutes.g.cs", fake.ClientName))
+		if err := renderFile(t, filePath, "fake_client.tmpl", fake); err != nil {
+			return fmt.Errorf("render fake routes %s: %w", fake.ClientName, err)
+		}
+	}
+	rootData := fakeRootTemplateData{
+		Namespace: g.config.Namespace,
+		Clients:   fakes,
+	}
+	if err := renderFile(t, filepath.Join(g.config.TestingOutputDir, "FakeSumUpHandler.g.cs"), "fake_root.tmpl", rootData); err != nil {
+		return fmt.Errorf("render fake handler: %w", err)
+	}
+	return nil
+}
+
+func renderFile(t *template.Template, filePath, templateName string, data any) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("create directory: %w", err)
+	}
+	file, err := os.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	if err := t.ExecuteTemplate(file, templateName, data); err != nil {
+		if closeErr := file.Close(); closeErr != nil {
+			return errors.Join(err, closeErr)
+		}
+		return err
+	}
+	return file.Close()
+}
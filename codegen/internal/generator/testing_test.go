@@ -0,0 +1,140 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const fakeRoutesSpec = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "test",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/v0.1/checkouts/{checkout_id}": {
+      "get": {
+        "tags": ["Checkouts"],
+        "operationId": "GetCheckout",
+        "x-codegen": { "method_name": "get" },
+        "parameters": [
+          {
+            "name": "checkout_id",
+            "in": "path",
+            "required": true,
+            "schema": { "type": "string" }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "content": {
+              "application/json": {
+                "schema": { "$ref": "#/components/schemas/Checkout" }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/v0.1/checkouts/{checkout_id}/receipt": {
+      "get": {
+        "tags": ["Checkouts"],
+        "operationId": "GetReceipt",
+        "parameters": [
+          {
+            "name": "checkout_id",
+            "in": "path",
+            "required": true,
+            "schema": { "type": "string" }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "content": {
+              "text/plain": {
+                "schema": { "type": "string" }
+              }
+            }
+          }
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Checkout": {
+        "type": "object",
+        "properties": {
+          "id": { "type": "string" }
+        }
+      }
+    }
+  }
+}`
+
+func TestBuildFakeClients_MapsOperationsToRoutes(t *testing.T) {
+	doc := mustBuildV3Document(t, fakeRoutesSpec)
+
+	g := New(Config{Namespace: "SumUp"})
+	if _, err := g.buildModels(doc); err != nil {
+		t.Fatalf("buildModels() error = %v", err)
+	}
+	clients, err := g.buildClients(doc)
+	if err != nil {
+		t.Fatalf("buildClients() error = %v", err)
+	}
+
+	fakes := buildFakeClients("SumUp", clients)
+	if len(fakes) != 1 || len(fakes[0].Routes) != 2 {
+		t.Fatalf("unexpected fake client/route count: %#v", fakes)
+	}
+
+	get := fakes[0].Routes[0]
+	if get.MethodName != "Get" {
+		t.Fatalf("route method name = %q, want %q", get.MethodName, "Get")
+	}
+	if get.ResponseType != "Checkout" {
+		t.Fatalf("route response type = %q, want %q", get.ResponseType, "Checkout")
+	}
+	if get.ContentType != "application/json" {
+		t.Fatalf("route content type = %q, want %q", get.ContentType, "application/json")
+	}
+	if len(get.PathParams) != 1 || get.PathParams[0].Declaration != "string? checkoutId = null" {
+		t.Fatalf("route path params = %#v", get.PathParams)
+	}
+
+	receipt := fakes[0].Routes[1]
+	if receipt.ContentType != "text/plain" {
+		t.Fatalf("text route content type = %q, want %q", receipt.ContentType, "text/plain")
+	}
+}
+
+func TestRun_WritesFakeRoutesToTestingOutput(t *testing.T) {
+	doc := mustBuildV3Document(t, fakeRoutesSpec)
+	outputDir := t.TempDir()
+	testingDir := t.TempDir()
+
+	g := New(Config{OutputDir: outputDir, TestingOutputDir: testingDir, Namespace: "SumUp"})
+	if err := g.Run(doc); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	routes, err := os.ReadFile(filepath.Join(testingDir, "FakeCheckoutsRoutes.g.cs"))
+	if err != nil {
+		t.Fatalf("read fake routes: %v", err)
+	}
+	if !strings.Contains(string(routes), "public FakeRoute<Checkout> OnGet(string? checkoutId = null)") {
+		t.Fatalf("fake routes do not expose OnGet:\n%s", routes)
+	}
+	handler, err := os.ReadFile(filepath.Join(testingDir, "FakeSumUpHandler.g.cs"))
+	if err != nil {
+		t.Fatalf("read fake handler: %v", err)
+	}
+	if !strings.Contains(string(handler), "Checkouts = new FakeCheckoutsRoutes(this);") {
+		t.Fatalf("fake handler does not initialize the Checkouts routes:\n%s", handler)
+	}
+}
@@ -0,0 +1,115 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
+)
+
+// Finding is a single rule violation.
+type Finding struct {
+	Rule     string        `json:"rule"`
+	Severity diag.Severity `json:"severity"`
+	// Pointer is the JSON pointer of the offending spec location.
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+// Rule checks one aspect of a document.
+type Rule struct {
+	ID          string
+	Severity    diag.Severity
+	Description string
+	check       func(doc *v3.Document, report func(pointer, format string, args ...any))
+}
+
+// Rules returns every available rule, sorted by ID.
+func Rules() []Rule {
+	rules := []Rule{
+		{
+			ID:          "operation-id",
+			Severity:    diag.Error,
+			Description: "Operations must declare an operationId; code samples and diagnostics are keyed by it.",
+			check:       checkOperationID,
+		},
+		{
+			ID:          "method-name",
+			Severity:    diag.Warning,
+			Description: "Operations should set x-codegen.method_name so SDK method names do not depend on the operationId.",
+			check:       checkMethodName,
+		},
+		{
+			ID:          "method-name-collision",
+			Severity:    diag.Error,
+			Description: "Operations of the same client must not resolve to the same method name; the generator would number them.",
+			check:       checkMethodNameCollision,
+		},
+		{
+			ID:          "json-document-fallback",
+			Severity:    diag.Warning,
+			Description: "Schemas the generator cannot map to a C# type degrade to JsonDocument.",
+			check:       checkJSONDocumentFallback,
+		},
+		{
+			ID:          "undocumented-success-body",
+			Severity:    diag.Warning,
+			Description: "2xx responses other than 204 should document their body; the SDK otherwise returns JsonDocument.",
+			check:       checkUndocumentedSuccessBody,
+		},
+		{
+			ID:          "unknown-extension",
+			Severity:    diag.Info,
+			Description: "Specification extensions and x-codegen keys the generator does not know about are ignored.",
+			check:       checkUnknownExtensions,
+		},
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+// Options selects which rules run.
+type Options struct {
+	// Disabled lists the IDs of rules to skip.
+	Disabled []string
+}
+
+// Run applies every enabled rule to the document and returns the findings
+// ordered by pointer and rule.
+func Run(doc *v3.Document, opts Options) ([]Finding, error) {
+	disabled := map[string]struct{}{}
+	known := map[string]struct{}{}
+	for _, rule := range Rules() {
+		known[rule.ID] = struct{}{}
+	}
+	for _, id := range opts.Disabled {
+		if _, ok := known[id]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %q", id)
+		}
+		disabled[id] = struct{}{}
+	}
+
+	findings := make([]Finding, 0)
+	for _, rule := range Rules() {
+		if _, skip := disabled[rule.ID]; skip {
+			continue
+		}
+		rule.check(doc, func(pointer, format string, args ...any) {
+			findings = append(findings, Finding{
+				Rule:     rule.ID,
+				Severity: rule.Severity,
+				Pointer:  pointer,
+				Message:  fmt.Sprintf(format, args...),
+			})
+		})
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Pointer != findings[j].Pointer {
+			return findings[i].Pointer < findings[j].Pointer
+		}
+		return findings[i].Rule < findings[j].Rule
+	})
+	return findings, nil
+}
@@ -0,0 +1,168 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
+)
+
+const lintSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "x-logo": { "url": "https://example.com/logo.png" },
+  "paths": {
+    "/v0.1/readers": {
+      "get": {
+        "tags": ["Readers"],
+        "operationId": "ListReaders",
+        "x-codegen": { "method_name": "list", "ignore": true },
+        "responses": {
+          "200": {
+            "description": "ok",
+            "content": {
+              "application/json": {
+                "schema": { "type": "array", "items": { "$ref": "#/components/schemas/Reader" } }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": ["Readers"],
+        "x-codegen": { "method_name": "list" },
+        "responses": { "201": { "description": "created" } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Reader": {
+        "type": "object",
+        "properties": {
+          "id": { "type": "string", "example": { "x-not-an-extension": true } },
+          "x-name": { "type": "string" },
+          "device": { "oneOf": [{ "type": "string" }, { "type": "integer" }] }
+        }
+      }
+    }
+  }
+}`
+
+func TestRun_ReportsEveryRule(t *testing.T) {
+	findings, err := Run(mustBuildV3Document(t, lintSpec), Options{})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := []string{
+		"warning json-document-fallback #/components/schemas/Reader/properties/device",
+		"info unknown-extension #/paths/~1v0.1~1readers/get/x-codegen/ignore",
+		"error method-name-collision #/paths/~1v0.1~1readers/post",
+		"error operation-id #/paths/~1v0.1~1readers/post",
+		"warning undocumented-success-body #/paths/~1v0.1~1readers/post/responses/201",
+		"info unknown-extension #/x-logo",
+	}
+	got := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		got = append(got, finding.Severity.String()+" "+finding.Rule+" "+finding.Pointer)
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("Run() findings =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+const codegenKeysSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {
+    "/v0.1/readers/{id}": {
+      "put": {
+        "tags": ["Readers"],
+        "operationId": "UpdateReader",
+        "x-codegen": { "patch_builder": "merge" },
+        "parameters": [{ "name": "id", "in": "path", "required": true, "schema": { "type": "string" } }],
+        "requestBody": { "content": { "application/json": { "schema": { "$ref": "#/components/schemas/ReaderUpdate" } } } },
+        "responses": { "204": { "description": "ok" } }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "ReaderUpdate": {
+        "type": "object",
+        "x-codegen": { "partial_update": true },
+        "properties": { "name": { "type": "string" } }
+      }
+    }
+  }
+}`
+
+func TestRun_AcceptsGeneratorOptions(t *testing.T) {
+	findings, err := Run(mustBuildV3Document(t, codegenKeysSpec), Options{})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for _, finding := range findings {
+		if finding.Rule == "unknown-extension" {
+			t.Errorf("Run() reported %s: %s", finding.Pointer, finding.Message)
+		}
+	}
+}
+
+func TestRun_DisablesRules(t *testing.T) {
+	doc := mustBuildV3Document(t, lintSpec)
+
+	findings, err := Run(doc, Options{Disabled: []string{"unknown-extension", "method-name-collision"}})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for _, finding := range findings {
+		if finding.Rule == "unknown-extension" || finding.Rule == "method-name-collision" {
+			t.Fatalf("disabled rule reported: %+v", finding)
+		}
+	}
+
+	if _, err := Run(doc, Options{Disabled: []string{"no-such-rule"}}); err == nil {
+		t.Fatalf("Run() error = nil, want unknown rule error")
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	findings := []Finding{{Rule: "operation-id", Severity: diag.Error, Pointer: "#/paths/~1me/get", Message: "GET /me has no operationId"}}
+
+	var buf bytes.Buffer
+	if err := WriteSARIF(&buf, findings, "openapi.json"); err != nil {
+		t.Fatalf("WriteSARIF() error = %v", err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("unmarshal sarif: %v", err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 || len(log.Runs[0].Results) != 1 {
+		t.Fatalf("unexpected sarif log: %s", buf.String())
+	}
+	result := log.Runs[0].Results[0]
+	if result.Level != "error" || result.Locations[0].LogicalLocations[0].FullyQualifiedName != "#/paths/~1me/get" {
+		t.Fatalf("unexpected sarif result: %+v", result)
+	}
+}
+
+func mustBuildV3Document(t *testing.T, raw string) *v3.Document {
+	t.Helper()
+
+	document, err := libopenapi.NewDocument([]byte(raw))
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	model, err := document.BuildV3Model()
+	if err != nil {
+		t.Fatalf("BuildV3Model() error = %v", err)
+	}
+	return &model.Model
+}
@@ -0,0 +1,144 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
+)
+
+// WriteText writes one line per finding followed by a summary line.
+func WriteText(w io.Writer, findings []Finding) error {
+	counts := map[diag.Severity]int{}
+	for _, finding := range findings {
+		counts[finding.Severity]++
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", finding.Severity, finding.Rule, finding.Pointer, finding.Message); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d errors, %d warnings, %d info\n", counts[diag.Error], counts[diag.Warning], counts[diag.Info])
+	return err
+}
+
+// WriteJSON writes the findings as an indented JSON array.
+func WriteJSON(w io.Writer, findings []Finding) error {
+	encoded, err := json.MarshalIndent(findings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode findings: %w", err)
+	}
+	_, err = w.Write(append(encoded, '\n'))
+	return err
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	LogicalLocations []sarifLogical        `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifact `json:"artifactLocation"`
+}
+
+type sarifArtifact struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogical struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// WriteSARIF writes the findings as a SARIF 2.1.0 log so CI systems can
+// annotate the specification. specURI is reported as the artifact location.
+func WriteSARIF(w io.Writer, findings []Finding, specURI string) error {
+	rules := make([]sarifRule, 0)
+	for _, rule := range Rules() {
+		rules = append(rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(rule.Severity)},
+		})
+	}
+	results := make([]sarifResult, 0, len(findings))
+	for _, finding := range findings {
+		results = append(results, sarifResult{
+			RuleID:  finding.Rule,
+			Level:   sarifLevel(finding.Severity),
+			Message: sarifMessage{Text: finding.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifact{URI: specURI}},
+				LogicalLocations: []sarifLogical{{FullyQualifiedName: finding.Pointer}},
+			}},
+		})
+	}
+	encoded, err := json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "sumup-dotnet-codegen",
+				InformationURI: "https://github.com/sumup/sumup-dotnet/tree/main/codegen",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode sarif: %w", err)
+	}
+	_, err = w.Write(append(encoded, '\n'))
+	return err
+}
+
+func sarifLevel(severity diag.Severity) string {
+	switch severity {
+	case diag.Error:
+		return "error"
+	case diag.Warning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,304 @@
+package lint
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/generator"
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+)
+
+const maxSchemaDepth = 32
+
+// codegenKeys lists the x-codegen keys the generator reads.
+var codegenKeys = func() map[string]struct{} {
+	keys := make(map[string]struct{}, len(generator.CodegenExtensionKeys))
+	for _, key := range generator.CodegenExtensionKeys {
+		keys[key] = struct{}{}
+	}
+	return keys
+}()
+
+// knownExtensions lists extensions that are either read by the generator or
+// used by the API documentation and the other SumUp SDK generators.
+var knownExtensions = map[string]struct{}{
+	generator.CodegenExtension: {},
+	"x-beta":                   {},
+	"x-core-objects":           {},
+	"x-deprecation-notice":     {},
+	"x-document":               {},
+	"x-go-type":                {},
+	"x-go-type-import":         {},
+	"x-permissions":            {},
+	"x-scopes":                 {},
+}
+
+// namedMaps are mapping keys whose children are user-chosen names rather
+// than OpenAPI fields, so an "x-" key below them is not an extension.
+var namedMaps = map[string]struct{}{
+	"callbacks":         {},
+	"content":           {},
+	"dependentSchemas":  {},
+	"encoding":          {},
+	"examples":          {},
+	"headers":           {},
+	"links":             {},
+	"mapping":           {},
+	"parameters":        {},
+	"pathItems":         {},
+	"patternProperties": {},
+	"properties":        {},
+	"requestBodies":     {},
+	"responses":         {},
+	"schemas":           {},
+	"scopes":            {},
+	"securitySchemes":   {},
+	"variables":         {},
+	"$defs":             {},
+}
+
+// valueKeys hold literal values that are never inspected for extensions.
+var valueKeys = map[string]struct{}{
+	"const":   {},
+	"default": {},
+	"enum":    {},
+	"example": {},
+	"value":   {},
+}
+
+type operationRef struct {
+	path      string
+	method    string
+	operation *v3.Operation
+}
+
+func (o operationRef) pointer(tokens ...string) string {
+	return spec.Pointer(append([]string{"paths", o.path, o.method}, tokens...)...)
+}
+
+func operations(doc *v3.Document) []operationRef {
+	var result []operationRef
+	if doc == nil || doc.Paths == nil || doc.Paths.PathItems == nil {
+		return result
+	}
+	for path, pathItem := range doc.Paths.PathItems.FromOldest() {
+		if pathItem == nil {
+			continue
+		}
+		for method, operation := range pathItem.GetOperations().FromOldest() {
+			if operation != nil {
+				result = append(result, operationRef{path: path, method: strings.ToLower(method), operation: operation})
+			}
+		}
+	}
+	return result
+}
+
+func checkOperationID(doc *v3.Document, report func(pointer, format string, args ...any)) {
+	for _, op := range operations(doc) {
+		if strings.TrimSpace(op.operation.OperationId) == "" {
+			report(op.pointer(), "%s %s has no operationId", strings.ToUpper(op.method), op.path)
+		}
+	}
+}
+
+func checkMethodName(doc *v3.Document, report func(pointer, format string, args ...any)) {
+	for _, op := range operations(doc) {
+		node := extension(op.operation.Extensions, generator.CodegenExtension)
+		if value := mappingValue(node, generator.MethodNameExtension); value == nil || strings.TrimSpace(value.Value) == "" {
+			report(op.pointer(), "%s %s has no x-codegen.method_name; the SDK method will be named %q",
+				strings.ToUpper(op.method), op.path, generator.MethodName(op.method, op.path, op.operation))
+		}
+	}
+}
+
+func checkMethodNameCollision(doc *v3.Document, report func(pointer, format string, args ...any)) {
+	seen := map[string]operationRef{}
+	for _, op := range operations(doc) {
+		client := generator.ClientName(op.operation)
+		method := generator.MethodName(op.method, op.path, op.operation)
+		key := client + "." + method
+		if first, ok := seen[key]; ok {
+			report(op.pointer(), "%s %s resolves to %sClient.%s, already used by %s %s",
+				strings.ToUpper(op.method), op.path, client, method, strings.ToUpper(first.method), first.path)
+			continue
+		}
+		seen[key] = op
+	}
+}
+
+func checkUndocumentedSuccessBody(doc *v3.Document, report func(pointer, format string, args ...any)) {
+	for _, op := range operations(doc) {
+		if op.operation.Responses == nil || op.operation.Responses.Codes == nil {
+			continue
+		}
+		for code, response := range op.operation.Responses.Codes.FromOldest() {
+			if !strings.HasPrefix(code, "2") || code == "204" || response == nil {
+				continue
+			}
+			if response.Content == nil || response.Content.Len() == 0 {
+				report(op.pointer("responses", code), "%s response of %s %s documents no body", code, strings.ToUpper(op.method), op.path)
+			}
+		}
+	}
+}
+
+func checkJSONDocumentFallback(doc *v3.Document, report func(pointer, format string, args ...any)) {
+	visit := func(schema *base.Schema, pointer string) {
+		if reason := generator.JSONDocumentFallback(schema); reason != "" {
+			report(pointer, "schema %s and is generated as JsonDocument", reason)
+		}
+	}
+	if doc.Components != nil && doc.Components.Schemas != nil {
+		for name, proxy := range doc.Components.Schemas.FromOldest() {
+			walkSchema(proxy, spec.Pointer("components", "schemas", name), 0, visit)
+		}
+	}
+	for _, op := range operations(doc) {
+		for index, parameter := range op.operation.Parameters {
+			if parameter != nil {
+				walkSchema(parameter.Schema, op.pointer("parameters", strconv.Itoa(index), "schema"), 0, visit)
+			}
+		}
+		if op.operation.RequestBody != nil && op.operation.RequestBody.Content != nil {
+			for contentType, mediaType := range op.operation.RequestBody.Content.FromOldest() {
+				if mediaType != nil {
+					walkSchema(mediaType.Schema, op.pointer("requestBody", "content", contentType, "schema"), 0, visit)
+				}
+			}
+		}
+		if op.operation.Responses == nil || op.operation.Responses.Codes == nil {
+			continue
+		}
+		for code, response := range op.operation.Responses.Codes.FromOldest() {
+			if response == nil || response.Content == nil {
+				continue
+			}
+			for contentType, mediaType := range response.Content.FromOldest() {
+				if mediaType != nil {
+					walkSchema(mediaType.Schema, op.pointer("responses", code, "content", contentType, "schema"), 0, visit)
+				}
+			}
+		}
+	}
+}
+
+// walkSchema visits inline schemas depth-first. References are not followed;
+// the component schemas they point to are walked separately.
+func walkSchema(proxy *base.SchemaProxy, pointer string, depth int, visit func(*base.Schema, string)) {
+	if proxy == nil || proxy.IsReference() || depth > maxSchemaDepth {
+		return
+	}
+	schema := proxy.Schema()
+	if schema == nil {
+		return
+	}
+	visit(schema, pointer)
+	if schema.Properties != nil {
+		for name, property := range schema.Properties.FromOldest() {
+			walkSchema(property, spec.AppendPointer(pointer, "properties", name), depth+1, visit)
+		}
+	}
+	if schema.Items != nil && schema.Items.IsA() {
+		walkSchema(schema.Items.A, pointer+"/items", depth+1, visit)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		walkSchema(schema.AdditionalProperties.A, pointer+"/additionalProperties", depth+1, visit)
+	}
+	for index, part := range schema.AllOf {
+		walkSchema(part, pointer+"/allOf/"+strconv.Itoa(index), depth+1, visit)
+	}
+	for index, part := range schema.OneOf {
+		walkSchema(part, pointer+"/oneOf/"+strconv.Itoa(index), depth+1, visit)
+	}
+	for index, part := range schema.AnyOf {
+		walkSchema(part, pointer+"/anyOf/"+strconv.Itoa(index), depth+1, visit)
+	}
+}
+
+func checkUnknownExtensions(doc *v3.Document, report func(pointer, format string, args ...any)) {
+	if doc == nil || doc.Index == nil {
+		return
+	}
+	root := doc.Index.GetRootNode()
+	if root != nil && root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	var found []Finding
+	walkExtensions(root, "#", false, func(pointer, key string, value *yaml.Node) {
+		if _, ok := knownExtensions[key]; !ok {
+			found = append(found, Finding{Pointer: pointer, Message: "extension " + key + " is not used by the generator"})
+			return
+		}
+		if key != generator.CodegenExtension {
+			return
+		}
+		if value.Kind != yaml.MappingNode {
+			found = append(found, Finding{Pointer: pointer, Message: "x-codegen must be an object"})
+			return
+		}
+		for i := 0; i+1 < len(value.Content); i += 2 {
+			name := value.Content[i].Value
+			if _, ok := codegenKeys[name]; !ok {
+				found = append(found, Finding{Pointer: spec.AppendPointer(pointer, name), Message: "x-codegen." + name + " is not a known generator option"})
+			}
+		}
+	})
+	sort.SliceStable(found, func(i, j int) bool { return found[i].Pointer < found[j].Pointer })
+	for _, finding := range found {
+		report(finding.Pointer, "%s", finding.Message)
+	}
+}
+
+func walkExtensions(node *yaml.Node, pointer string, named bool, visit func(pointer, key string, value *yaml.Node)) {
+	if node == nil {
+		return
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			value := node.Content[i+1]
+			child := spec.AppendPointer(pointer, key)
+			if !named && strings.HasPrefix(key, "x-") {
+				visit(child, key, value)
+				continue
+			}
+			if _, ok := valueKeys[key]; ok && !named {
+				continue
+			}
+			_, childNamed := namedMaps[key]
+			walkExtensions(value, child, childNamed && !named, visit)
+		}
+	case yaml.SequenceNode:
+		for index, item := range node.Content {
+			walkExtensions(item, pointer+"/"+strconv.Itoa(index), false, visit)
+		}
+	}
+}
+
+func extension(extensions *orderedmap.Map[string, *yaml.Node], name string) *yaml.Node {
+	if extensions == nil {
+		return nil
+	}
+	node, _ := extensions.Get(name)
+	return node
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/examples"
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+	"github.com/sumup/sumup-dotnet/codegen/internal/validate"
+)
+
+// Options configures the mock server.
+type Options struct {
+	// Logger receives one line per request and one per validation issue.
+	// Logging is disabled when nil.
+	Logger *log.Logger
+}
+
+// Server answers requests with the examples documented for the matching operation.
+type Server struct {
+	router *spec.Router
+	logger *log.Logger
+}
+
+// New returns a mock server for the document.
+func New(doc *v3.Document, opts Options) *Server {
+	return &Server{router: spec.NewRouter(doc), logger: opts.Logger}
+}
+
+// ServeHTTP implements http.Handler.
+//
+// The response status is the lowest documented 2xx status unless the request
+// carries a `Prefer: code=404` header. A `Prefer: example=name` header selects
+// a named example from the response media type.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, pathParams, ok := s.router.Match(r.Method, r.URL.EscapedPath())
+	if !ok {
+		if allowed := s.router.Allowed(r.URL.EscapedPath()); len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			s.problem(w, r, http.StatusMethodNotAllowed, fmt.Sprintf("%s is not documented for %s", r.Method, r.URL.Path))
+			return
+		}
+		s.problem(w, r, http.StatusNotFound, fmt.Sprintf("no operation documented for %s", r.URL.Path))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.problem(w, r, http.StatusBadRequest, fmt.Sprintf("read request body: %v", err))
+		return
+	}
+	for _, issue := range validate.Request(route, pathParams, r.URL.Query(), r.Header, body, validate.Options{}) {
+		s.logf("%s %s: %s", r.Method, r.URL.Path, issue)
+	}
+
+	prefer := parsePrefer(r.Header.Values("Prefer"))
+	status, response, err := selectResponse(route.Operation, prefer["code"])
+	if err != nil {
+		s.problem(w, r, http.StatusNotImplemented, err.Error())
+		return
+	}
+	s.logf("%s %s -> %d (%s)", r.Method, r.URL.Path, status, route.Path)
+	if response == nil || response.Content == nil || response.Content.Len() == 0 {
+		w.WriteHeader(status)
+		return
+	}
+
+	contentType, payload, err := responseBody(response, prefer["example"])
+	if err != nil {
+		s.problem(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	// Flag examples that drifted from their schema so they get fixed upstream.
+	header := http.Header{"Content-Type": []string{contentType}}
+	for _, issue := range validate.Response(route, status, header, payload, validate.Options{}) {
+		s.logf("%s %s: served example does not match the schema: %s", r.Method, r.URL.Path, issue)
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_, _ = w.Write(payload)
+}
+
+func (s *Server) problem(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	s.logf("%s %s -> %d: %s", r.Method, r.URL.Path, status, detail)
+	payload, _ := json.Marshal(map[string]any{
+		"title":  http.StatusText(status),
+		"status": status,
+		"detail": detail,
+	})
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_, _ = w.Write(payload)
+}
+
+func (s *Server) logf(format string, args ...any) {
+	if s.logger != nil {
+		s.logger.Printf(format, args...)
+	}
+}
+
+// selectResponse returns the documented response for the requested status,
+// falling back to the `default` response when the code is not listed.
+func selectResponse(operation *v3.Operation, requested string) (int, *v3.Response, error) {
+	if operation.Responses == nil {
+		return 0, nil, fmt.Errorf("operation %s documents no responses", operation.OperationId)
+	}
+	if requested != "" {
+		status, err := strconv.Atoi(requested)
+		if err != nil || status < 100 || status > 599 {
+			return 0, nil, fmt.Errorf("invalid Prefer code %q", requested)
+		}
+		if response, ok := validate.DocumentedResponse(operation, status); ok {
+			return status, response, nil
+		}
+		return 0, nil, fmt.Errorf("operation %s does not document status %s", operation.OperationId, requested)
+	}
+
+	codes := make([]string, 0)
+	if operation.Responses.Codes != nil {
+		for code := range operation.Responses.Codes.KeysFromOldest() {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if strings.HasPrefix(code, "2") {
+			status, err := strconv.Atoi(code)
+			if err != nil {
+				status = http.StatusOK
+			}
+			response, _ := operation.Responses.Codes.Get(code)
+			return status, response, nil
+		}
+	}
+	if operation.Responses.Default != nil {
+		return http.StatusOK, operation.Responses.Default, nil
+	}
+	return 0, nil, fmt.Errorf("operation %s documents no success response", operation.OperationId)
+}
+
+func responseBody(response *v3.Response, exampleName string) (string, []byte, error) {
+	contentType, mediaType := preferredMediaType(response)
+	if mediaType == nil {
+		return contentType, nil, nil
+	}
+
+	var value any
+	found := false
+	if mediaType.Examples != nil && mediaType.Examples.Len() > 0 {
+		if exampleName != "" {
+			example, ok := mediaType.Examples.Get(exampleName)
+			if !ok {
+				return "", nil, fmt.Errorf("example %q is not documented", exampleName)
+			}
+			value, found = examples.Decode(example.Value)
+		} else {
+			for _, example := range mediaType.Examples.FromOldest() {
+				if example != nil {
+					value, found = examples.Decode(example.Value)
+					break
+				}
+			}
+		}
+	}
+	if !found {
+		value, found = examples.Decode(mediaType.Example)
+	}
+	if !found {
+		value = examples.Value(mediaType.Schema, examples.Response)
+	}
+
+	if text, ok := value.(string); ok && !spec.IsJSON(contentType) {
+		return contentType, []byte(text), nil
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return "", nil, fmt.Errorf("encode example: %w", err)
+	}
+	return contentType, payload, nil
+}
+
+func preferredMediaType(response *v3.Response) (string, *v3.MediaType) {
+	for contentType, mediaType := range response.Content.FromOldest() {
+		if spec.IsJSON(contentType) {
+			return contentType, mediaType
+		}
+	}
+	for contentType, mediaType := range response.Content.FromOldest() {
+		return contentType, mediaType
+	}
+	return "", nil
+}
+
+// parsePrefer reads `key=value` preferences from RFC 7240 Prefer headers.
+func parsePrefer(headers []string) map[string]string {
+	preferences := map[string]string{}
+	for _, header := range headers {
+		for _, part := range strings.FieldsFunc(header, func(r rune) bool { return r == ',' || r == ';' }) {
+			key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+			if !ok {
+				continue
+			}
+			preferences[strings.ToLower(strings.TrimSpace(key))] = strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	return preferences
+}
@@ -0,0 +1,212 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+)
+
+const mockSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {
+    "/v0.1/readers/{reader_id}": {
+      "get": {
+        "operationId": "GetReader",
+        "parameters": [
+          { "name": "reader_id", "in": "path", "required": true, "schema": { "type": "string", "pattern": "^rdr_" } }
+        ],
+        "responses": {
+          "200": {
+            "description": "ok",
+            "content": {
+              "application/json": {
+                "schema": { "$ref": "#/components/schemas/Reader" },
+                "examples": {
+                  "paired": { "value": { "id": "rdr_1", "status": "paired" } },
+                  "expired": { "value": { "id": "rdr_2", "status": "expired" } }
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "not found",
+            "content": {
+              "application/problem+json": {
+                "schema": { "type": "object", "properties": { "title": { "type": "string", "example": "Not Found" } } }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/v0.1/readers": {
+      "post": {
+        "operationId": "CreateReader",
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "required": ["name"],
+                "properties": { "name": { "type": "string" } }
+              }
+            }
+          }
+        },
+        "responses": {
+          "201": {
+            "description": "created",
+            "content": {
+              "application/json": { "schema": { "$ref": "#/components/schemas/Reader" } }
+            }
+          }
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Reader": {
+        "type": "object",
+        "properties": {
+          "id": { "type": "string", "example": "rdr_example" },
+          "status": { "type": "string", "enum": ["paired", "expired"] },
+          "created_at": { "type": "string", "format": "date-time" }
+        }
+      }
+    }
+  }
+}`
+
+func newTestServer(t *testing.T) (*httptest.Server, *bytes.Buffer) {
+	t.Helper()
+
+	document, err := libopenapi.NewDocument([]byte(mockSpec))
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	model, err := document.BuildV3Model()
+	if err != nil {
+		t.Fatalf("BuildV3Model() error = %v", err)
+	}
+
+	var logs bytes.Buffer
+	server := httptest.NewServer(New(&model.Model, Options{Logger: log.New(&logs, "", 0)}))
+	t.Cleanup(server.Close)
+	return server, &logs
+}
+
+func TestServer_ServesFirstDocumentedExample(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	body := doJSON(t, server, http.MethodGet, "/v0.1/readers/rdr_1", nil, http.StatusOK)
+
+	if body["id"] != "rdr_1" || body["status"] != "paired" {
+		t.Fatalf("body = %#v, want the paired example", body)
+	}
+}
+
+func TestServer_PreferSelectsExampleAndStatus(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	body := doJSON(t, server, http.MethodGet, "/v0.1/readers/rdr_1", map[string]string{"Prefer": "example=expired"}, http.StatusOK)
+	if body["status"] != "expired" {
+		t.Fatalf("status = %v, want %q", body["status"], "expired")
+	}
+
+	body = doJSON(t, server, http.MethodGet, "/v0.1/readers/rdr_1", map[string]string{"Prefer": "code=404"}, http.StatusNotFound)
+	if body["title"] != "Not Found" {
+		t.Fatalf("title = %v, want %q", body["title"], "Not Found")
+	}
+
+	doJSON(t, server, http.MethodGet, "/v0.1/readers/rdr_1", map[string]string{"Prefer": "code=500"}, http.StatusNotImplemented)
+}
+
+func TestServer_SynthesizesResponseFromSchema(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/v0.1/readers", strings.NewReader(`{"name":"Front desk"}`))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	body := decodeResponse(t, request, http.StatusCreated)
+
+	if body["id"] != "rdr_example" {
+		t.Fatalf("id = %v, want %q", body["id"], "rdr_example")
+	}
+	if body["created_at"] != "2025-01-01T12:00:00Z" {
+		t.Fatalf("created_at = %v, want a date-time", body["created_at"])
+	}
+}
+
+func TestServer_LogsRequestMismatches(t *testing.T) {
+	server, logs := newTestServer(t)
+
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/v0.1/readers", strings.NewReader(`{"name":42}`))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	decodeResponse(t, request, http.StatusCreated)
+
+	if !strings.Contains(logs.String(), "body/name: expected string, got number") {
+		t.Fatalf("logs do not report the body mismatch:\n%s", logs.String())
+	}
+}
+
+func TestServer_DecodesEscapedPathParametersOnce(t *testing.T) {
+	server, logs := newTestServer(t)
+
+	doJSON(t, server, http.MethodGet, "/v0.1/readers/x%2F%2541", nil, http.StatusOK)
+
+	if !strings.Contains(logs.String(), `value "x/%41" does not match pattern`) {
+		t.Fatalf("logs do not report the decoded path parameter:\n%s", logs.String())
+	}
+}
+
+func TestServer_RejectsUndocumentedRoutes(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	doJSON(t, server, http.MethodGet, "/v0.1/unknown", nil, http.StatusNotFound)
+	doJSON(t, server, http.MethodDelete, "/v0.1/readers", nil, http.StatusMethodNotAllowed)
+}
+
+func doJSON(t *testing.T, server *httptest.Server, method, path string, headers map[string]string, wantStatus int) map[string]any {
+	t.Helper()
+
+	request, err := http.NewRequest(method, server.URL+path, nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	return decodeResponse(t, request, wantStatus)
+}
+
+func decodeResponse(t *testing.T, request *http.Request, wantStatus int) map[string]any {
+	t.Helper()
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != wantStatus {
+		t.Fatalf("%s %s status = %d, want %d", request.Method, request.URL.Path, response.StatusCode, wantStatus)
+	}
+	var body map[string]any
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	return body
+}
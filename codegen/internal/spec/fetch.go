@@ -0,0 +1,202 @@
+package spec
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+var defaultHTTPClient = &http.Client{Timeout: time.Minute}
+
+// fetcher downloads remote documents, keeping an ETag-validated copy in
+// cacheDir when it is set.
+type fetcher struct {
+	ctx      context.Context
+	client   *http.Client
+	cacheDir string
+	offline  bool
+	logger   *log.Logger
+}
+
+func newFetcher(ctx context.Context, opts LoadOptions) *fetcher {
+	client := opts.HTTPClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	return &fetcher{ctx: ctx, client: client, cacheDir: opts.CacheDir, offline: opts.Offline, logger: opts.Logger}
+}
+
+// read returns the document at a file path, http(s) URL or "-" for stdin.
+func (f *fetcher) read(location string, stdin io.Reader) ([]byte, error) {
+	switch {
+	case location == "-":
+		if stdin == nil {
+			stdin = os.Stdin
+		}
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("read stdin: %w", err)
+		}
+		return data, nil
+	case isRemote(location):
+		return f.fetch(location)
+	default:
+		data, err := os.ReadFile(location)
+		if err != nil {
+			return nil, fmt.Errorf("read file: %w", err)
+		}
+		return data, nil
+	}
+}
+
+// handle adapts fetch to libopenapi's remote reference handler.
+func (f *fetcher) handle(rawURL string) (*http.Response, error) {
+	body, err := f.fetch(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Header:        http.Header{},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}, nil
+}
+
+// fetch returns the document at rawURL. A cached copy is revalidated with its
+// ETag and used as-is, with a warning, when the server cannot be reached or
+// fails with a 5xx status.
+func (f *fetcher) fetch(rawURL string) ([]byte, error) {
+	cached, etag, validated, cacheErr := f.readCache(rawURL)
+	if f.offline {
+		if cacheErr != nil {
+			return nil, fmt.Errorf("fetch %s: not cached for offline use: %w", rawURL, cacheErr)
+		}
+		return cached, nil
+	}
+
+	request, err := http.NewRequestWithContext(f.ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s: %w", rawURL, err)
+	}
+	if cacheErr == nil && etag != "" {
+		request.Header.Set("If-None-Match", etag)
+	}
+	response, err := f.client.Do(request)
+	if err != nil {
+		if cacheErr == nil && f.ctx.Err() == nil {
+			f.warnStale(rawURL, err, validated)
+			return cached, nil
+		}
+		return nil, fmt.Errorf("fetch %s: %w", rawURL, err)
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusNotModified && cacheErr == nil:
+		f.touchCache(rawURL)
+		return cached, nil
+	case response.StatusCode >= http.StatusInternalServerError && cacheErr == nil:
+		f.warnStale(rawURL, fmt.Errorf("unexpected status %s", response.Status), validated)
+		return cached, nil
+	case response.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("fetch %s: unexpected status %s", rawURL, response.Status)
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s: read body: %w", rawURL, err)
+	}
+	if err := f.writeCache(rawURL, body, response.Header.Get("ETag")); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (f *fetcher) cachePath(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return filepath.Join(f.cacheDir, hex.EncodeToString(sum[:]))
+}
+
+// warnStale reports that the cached copy of rawURL, last fetched or
+// revalidated at validated, stands in for the document the server did not
+// return.
+func (f *fetcher) warnStale(rawURL string, err error, validated time.Time) {
+	if f.logger == nil {
+		return
+	}
+	f.logger.Printf("fetch %s: %v; using the copy cached %s ago", rawURL, err, time.Since(validated).Round(time.Second))
+}
+
+// readCache returns the cached body and ETag of rawURL, and when the server
+// last returned or revalidated them. The error wraps fs.ErrNotExist when
+// nothing is cached.
+func (f *fetcher) readCache(rawURL string) ([]byte, string, time.Time, error) {
+	if f.cacheDir == "" {
+		return nil, "", time.Time{}, fmt.Errorf("no cache directory: %w", fs.ErrNotExist)
+	}
+	base := f.cachePath(rawURL)
+	body, err := os.ReadFile(base + ".body")
+	if err != nil {
+		return nil, "", time.Time{}, err
+	}
+	info, err := os.Stat(base + ".body")
+	if err != nil {
+		return nil, "", time.Time{}, err
+	}
+	etag, err := os.ReadFile(base + ".etag")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, "", time.Time{}, err
+	}
+	return body, string(etag), info.ModTime(), nil
+}
+
+// touchCache records that the server revalidated the cached copy of rawURL.
+// The copy stays usable if this fails, so errors are ignored.
+func (f *fetcher) touchCache(rawURL string) {
+	now := time.Now()
+	_ = os.Chtimes(f.cachePath(rawURL)+".body", now, now)
+}
+
+func (f *fetcher) writeCache(rawURL string, body []byte, etag string) error {
+	if f.cacheDir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(f.cacheDir, 0o755); err != nil {
+		return fmt.Errorf("create spec cache: %w", err)
+	}
+	base := f.cachePath(rawURL)
+	if err := writeFileAtomic(base+".body", body); err != nil {
+		return fmt.Errorf("cache %s: %w", rawURL, err)
+	}
+	if etag == "" {
+		if err := os.Remove(base + ".etag"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("cache %s: %w", rawURL, err)
+		}
+		return nil
+	}
+	if err := writeFileAtomic(base+".etag", []byte(etag)); err != nil {
+		return fmt.Errorf("cache %s: %w", rawURL, err)
+	}
+	return nil
+}
+
+// writeFileAtomic replaces path so concurrent loads never see a partial file.
+func writeFileAtomic(path string, data []byte) error {
+	temp := path + ".tmp" + strconv.Itoa(os.Getpid())
+	if err := os.WriteFile(temp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(temp, path)
+}
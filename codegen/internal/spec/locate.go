@@ -0,0 +1,75 @@
+package spec
+
+import (
+	"strconv"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"go.yaml.in/yaml/v4"
+)
+
+// Locate returns the 1-based line and column of the element a JSON pointer
+// refers to, read from the YAML nodes libopenapi parsed the document into.
+// Object members are located at their key. When the pointer cannot be fully
+// resolved the position of the deepest resolvable ancestor is returned; both
+// values are zero when the document carries no source nodes.
+func Locate(doc *v3.Document, pointer string) (line, column int) {
+	if doc == nil || doc.Index == nil {
+		return 0, 0
+	}
+	node := doc.Index.GetRootNode()
+	if node != nil && node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node == nil {
+		return 0, 0
+	}
+	line, column = node.Line, node.Column
+	for _, token := range pointerTokens(pointer) {
+		key, value := child(node, token)
+		if value == nil {
+			break
+		}
+		if key == nil {
+			key = value
+		}
+		line, column = key.Line, key.Column
+		node = value
+	}
+	return line, column
+}
+
+// pointerTokens splits a JSON pointer, with or without the leading "#", into
+// unescaped reference tokens.
+func pointerTokens(pointer string) []string {
+	pointer = strings.TrimPrefix(pointer, "#")
+	if pointer == "" || pointer == "/" {
+		return nil
+	}
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+	return tokens
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// child returns the key and value of a mapping member, or only the value of a
+// sequence item, named by token.
+func child(node *yaml.Node, token string) (*yaml.Node, *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == token {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		index, err := strconv.Atoi(token)
+		if err == nil && index >= 0 && index < len(node.Content) {
+			return nil, node.Content[index]
+		}
+	}
+	return nil, nil
+}
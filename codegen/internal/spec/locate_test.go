@@ -0,0 +1,37 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+)
+
+func TestLocate(t *testing.T) {
+	document, err := libopenapi.NewDocument([]byte(routerSpec))
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	model, err := document.BuildV3Model()
+	if err != nil {
+		t.Fatalf("BuildV3Model() error = %v", err)
+	}
+
+	tests := []struct {
+		pointer string
+		line    int
+		column  int
+	}{
+		{pointer: "#", line: 1, column: 1},
+		{pointer: "#/paths", line: 4, column: 3},
+		{pointer: "#/paths/~1v0.1~1readers~1{reader_id}/delete", line: 7, column: 7},
+		{pointer: "#/paths/~1v0.1~1readers~1current/get/responses/200", line: 10, column: 66},
+		// Unknown members resolve to their closest documented ancestor.
+		{pointer: "#/paths/~1v0.1~1readers~1current/post", line: 9, column: 5},
+	}
+	for _, test := range tests {
+		line, column := Locate(&model.Model, test.pointer)
+		if line != test.line || column != test.column {
+			t.Errorf("Locate(%q) = %d:%d, want %d:%d", test.pointer, line, column, test.line, test.column)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package spec
+
+import (
+	"mime"
+	"strings"
+)
+
+// IsJSON reports whether contentType is a JSON media type: application/json
+// or a structured syntax suffix such as application/problem+json. Parameters
+// such as charset and the letter case are ignored.
+func IsJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, _, _ = strings.Cut(contentType, ";")
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,24 @@
+package spec
+
+import "testing"
+
+func TestIsJSON(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        bool
+	}{
+		{"application/json", true},
+		{"Application/JSON; charset=utf-8", true},
+		{"application/problem+json", true},
+		{"application/merge-patch+json;charset=utf-8", true},
+		{"application/json;", true},
+		{"text/plain", false},
+		{"application/jsonl", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := IsJSON(tt.contentType); got != tt.want {
+			t.Errorf("IsJSON(%q) = %v, want %v", tt.contentType, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/overlay"
+	"go.yaml.in/yaml/v4"
+)
+
+// applyOverlay applies an OpenAPI Overlay 1.0 document to data. Overlay
+// warnings, such as targets matching no node, are logged rather than failing
+// the load so overlays survive upstream changes to unrelated endpoints.
+func applyOverlay(data, overlayData []byte, location string, logger *log.Logger) ([]byte, error) {
+	parsed, err := libopenapi.NewOverlayDocument(overlayData)
+	if err != nil {
+		return nil, fmt.Errorf("overlay %s: parse: %w", location, err)
+	}
+	result, err := overlay.Apply(data, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("overlay %s: %w", location, err)
+	}
+	if logger != nil {
+		for _, warning := range result.Warnings {
+			logger.Printf("overlay %s: target %s: %s", location, warning.Target, warning.Message)
+		}
+	}
+	return result.Bytes, nil
+}
+
+// ToJSON re-encodes a YAML or JSON document as indented JSON, keeping the
+// order of object members.
+func ToJSON(data []byte) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+	var buffer bytes.Buffer
+	if err := writeJSON(&buffer, &root, ""); err != nil {
+		return nil, err
+	}
+	buffer.WriteByte('\n')
+	return buffer.Bytes(), nil
+}
+
+func writeJSON(buffer *bytes.Buffer, node *yaml.Node, indent string) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			buffer.WriteString("null")
+			return nil
+		}
+		return writeJSON(buffer, node.Content[0], indent)
+	case yaml.AliasNode:
+		return writeJSON(buffer, node.Alias, indent)
+	case yaml.MappingNode:
+		if len(node.Content) == 0 {
+			buffer.WriteString("{}")
+			return nil
+		}
+		buffer.WriteString("{\n")
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, err := json.Marshal(node.Content[i].Value)
+			if err != nil {
+				return err
+			}
+			buffer.WriteString(indent + "  ")
+			buffer.Write(key)
+			buffer.WriteString(": ")
+			if err := writeJSON(buffer, node.Content[i+1], indent+"  "); err != nil {
+				return err
+			}
+			if i+2 < len(node.Content) {
+				buffer.WriteByte(',')
+			}
+			buffer.WriteByte('\n')
+		}
+		buffer.WriteString(indent + "}")
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			buffer.WriteString("[]")
+			return nil
+		}
+		buffer.WriteString("[\n")
+		for i, item := range node.Content {
+			buffer.WriteString(indent + "  ")
+			if err := writeJSON(buffer, item, indent+"  "); err != nil {
+				return err
+			}
+			if i+1 < len(node.Content) {
+				buffer.WriteByte(',')
+			}
+			buffer.WriteByte('\n')
+		}
+		buffer.WriteString(indent + "]")
+	case yaml.ScalarNode:
+		return writeScalarJSON(buffer, node)
+	default:
+		return fmt.Errorf("line %d: unsupported YAML node kind %v", node.Line, node.Kind)
+	}
+	return nil
+}
+
+func writeScalarJSON(buffer *bytes.Buffer, node *yaml.Node) error {
+	switch node.ShortTag() {
+	case "!!null":
+		buffer.WriteString("null")
+		return nil
+	case "!!bool":
+		buffer.WriteString(strings.ToLower(node.Value))
+		return nil
+	case "!!int", "!!float":
+		if json.Valid([]byte(node.Value)) {
+			buffer.WriteString(node.Value)
+			return nil
+		}
+		var decoded any
+		if err := node.Decode(&decoded); err != nil {
+			return fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		encoded, err := json.Marshal(decoded)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		buffer.Write(encoded)
+		return nil
+	}
+	encoded, err := json.Marshal(node.Value)
+	if err != nil {
+		return err
+	}
+	buffer.Write(encoded)
+	return nil
+}
+
+// ToYAML re-encodes a YAML or JSON document as block-style YAML, keeping the
+// order of object members.
+func ToYAML(data []byte) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+	clearFlowStyle(&root)
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&root); err != nil {
+		return nil, fmt.Errorf("encode document: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("encode document: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// clearFlowStyle drops the flow and quoting styles JSON sources parse with,
+// letting the encoder pick the plainest YAML representation.
+func clearFlowStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle | yaml.DoubleQuotedStyle
+	for _, child := range node.Content {
+		clearFlowStyle(child)
+	}
+}
@@ -0,0 +1,89 @@
+package spec
+
+import (
+	"bytes"
+	"log"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testOverlay = `overlay: 1.0.0
+info:
+  title: SDK tweaks
+  version: 1.0.0
+actions:
+  - target: $.paths['/v0.1/readers/{reader_id}'].get
+    update:
+      x-codegen:
+        method_name: retrieve
+  - target: $.paths['/v0.1/files/{name}.{extension}']
+    remove: true
+  - target: $.paths['/v0.1/missing'].get
+    update:
+      summary: Never applied
+`
+
+func TestLoad_AppliesOverlays(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "openapi.json"), routerSpec)
+	writeTestFile(t, filepath.Join(dir, "sdk.overlay.yaml"), testOverlay)
+
+	var logs bytes.Buffer
+	doc, err := Load(t.Context(), filepath.Join(dir, "openapi.json"), LoadOptions{
+		Overlays: []string{filepath.Join(dir, "sdk.overlay.yaml")},
+		Logger:   log.New(&logs, "", 0),
+	})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if doc.Paths.PathItems.GetOrZero("/v0.1/files/{name}.{extension}") != nil {
+		t.Fatalf("removed path is still present")
+	}
+	get := doc.Paths.PathItems.GetOrZero("/v0.1/readers/{reader_id}").Get
+	if _, ok := get.Extensions.Get("x-codegen"); !ok {
+		t.Fatalf("updated operation has no x-codegen extension")
+	}
+	if !strings.Contains(logs.String(), "$.paths['/v0.1/missing'].get") {
+		t.Fatalf("overlay warnings = %q, want the unmatched target", logs.String())
+	}
+
+	// Diagnostics should still point at the re-indented JSON lines.
+	line, _ := Locate(doc, "#/paths/~1v0.1~1readers~1current/get")
+	if line <= 1 {
+		t.Fatalf("Locate() line = %d, want the overlaid JSON to span several lines", line)
+	}
+}
+
+func TestLoad_RejectsInvalidOverlays(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "openapi.json"), routerSpec)
+	writeTestFile(t, filepath.Join(dir, "broken.yaml"), "overlay: 1.0.0\ninfo:\n  title: broken\n  version: 1.0.0\nactions: []\n")
+
+	_, err := Load(t.Context(), filepath.Join(dir, "openapi.json"), LoadOptions{Overlays: []string{filepath.Join(dir, "broken.yaml")}})
+	if err == nil || !strings.Contains(err.Error(), "broken.yaml") {
+		t.Fatalf("Load() error = %v, want an error naming the overlay", err)
+	}
+}
+
+// ToJSON escapes HTML characters like encoding/json, matching the upstream spec.
+func TestToJSON_KeepsMemberOrder(t *testing.T) {
+	got, err := ToJSON([]byte("b: 1\na:\n  - true\n  - null\n  - \"<x>\"\nc: {}\n"))
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	const want = `{
+  "b": 1,
+  "a": [
+    true,
+    null,
+    "\u003cx\u003e"
+  ],
+  "c": {}
+}
+`
+	if string(got) != want {
+		t.Fatalf("ToJSON() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+package spec
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+var templateParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Route is a single operation addressable by HTTP method and path template.
+type Route struct {
+	Method    string
+	Path      string
+	PathItem  *v3.PathItem
+	Operation *v3.Operation
+
+	segments []routeSegment
+	literals int
+}
+
+type routeSegment struct {
+	literal string
+	pattern *regexp.Regexp
+	names   []string
+}
+
+// Router matches concrete request paths against the path templates of a document.
+type Router struct {
+	routes []*Route
+}
+
+// NewRouter indexes every operation in the document.
+func NewRouter(doc *v3.Document) *Router {
+	router := &Router{}
+	if doc == nil || doc.Paths == nil || doc.Paths.PathItems == nil {
+		return router
+	}
+	for rawPath, pathItem := range doc.Paths.PathItems.FromOldest() {
+		if pathItem == nil {
+			continue
+		}
+		operations := pathItem.GetOperations()
+		if operations == nil {
+			continue
+		}
+		for method, operation := range operations.FromOldest() {
+			if operation == nil {
+				continue
+			}
+			router.routes = append(router.routes, newRoute(strings.ToUpper(method), rawPath, pathItem, operation))
+		}
+	}
+	// Prefer the most literal template so /readers/current wins over /readers/{id}.
+	sort.SliceStable(router.routes, func(i, j int) bool {
+		return router.routes[i].literals > router.routes[j].literals
+	})
+	return router
+}
+
+// Routes returns every indexed route.
+func (r *Router) Routes() []*Route {
+	return r.routes
+}
+
+// Match returns the route serving the method and escaped request path, along
+// with the decoded values of its path parameters.
+func (r *Router) Match(method, path string) (*Route, map[string]string, bool) {
+	segments := splitPath(path)
+	method = strings.ToUpper(method)
+	for _, route := range r.routes {
+		if route.Method != method || len(route.segments) != len(segments) {
+			continue
+		}
+		if params, ok := route.match(segments); ok {
+			return route, params, true
+		}
+	}
+	return nil, nil, false
+}
+
+// Allowed returns the methods documented for the escaped request path.
+func (r *Router) Allowed(path string) []string {
+	segments := splitPath(path)
+	var methods []string
+	for _, route := range r.routes {
+		if len(route.segments) != len(segments) {
+			continue
+		}
+		if _, ok := route.match(segments); ok {
+			methods = append(methods, route.Method)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+func newRoute(method, path string, pathItem *v3.PathItem, operation *v3.Operation) *Route {
+	route := &Route{
+		Method:    method,
+		Path:      path,
+		PathItem:  pathItem,
+		Operation: operation,
+	}
+	for _, segment := range splitPath(path) {
+		matches := templateParamPattern.FindAllStringSubmatchIndex(segment, -1)
+		if len(matches) == 0 {
+			route.segments = append(route.segments, routeSegment{literal: segment})
+			route.literals++
+			continue
+		}
+		var (
+			expression strings.Builder
+			names      []string
+			last       int
+		)
+		expression.WriteString("^")
+		for _, match := range matches {
+			expression.WriteString(regexp.QuoteMeta(segment[last:match[0]]))
+			expression.WriteString("(.+?)")
+			names = append(names, segment[match[2]:match[3]])
+			last = match[1]
+		}
+		expression.WriteString(regexp.QuoteMeta(segment[last:]))
+		expression.WriteString("$")
+		route.segments = append(route.segments, routeSegment{
+			pattern: regexp.MustCompile(expression.String()),
+			names:   names,
+		})
+	}
+	return route
+}
+
+func (r *Route) match(segments []string) (map[string]string, bool) {
+	params := map[string]string{}
+	for i, segment := range r.segments {
+		actual := segments[i]
+		if segment.pattern == nil {
+			if decoded, err := url.PathUnescape(actual); err == nil {
+				actual = decoded
+			}
+			if actual != segment.literal {
+				return nil, false
+			}
+			continue
+		}
+		match := segment.pattern.FindStringSubmatch(actual)
+		if match == nil {
+			return nil, false
+		}
+		for index, name := range segment.names {
+			value := match[index+1]
+			if decoded, err := url.PathUnescape(value); err == nil {
+				value = decoded
+			}
+			params[name] = value
+		}
+	}
+	return params, true
+}
+
+func splitPath(path string) []string {
+	trimmed := strings.Trim(path, "/")
+	if trimmed == "" {
+		return nil
+	}
+	return strings.Split(trimmed, "/")
+}
@@ -0,0 +1,69 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+)
+
+const routerSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {
+    "/v0.1/readers/{reader_id}": {
+      "get": { "operationId": "GetReader", "responses": { "200": { "description": "ok" } } },
+      "delete": { "operationId": "DeleteReader", "responses": { "204": { "description": "deleted" } } }
+    },
+    "/v0.1/readers/current": {
+      "get": { "operationId": "GetCurrentReader", "responses": { "200": { "description": "ok" } } }
+    },
+    "/v0.1/files/{name}.{extension}": {
+      "get": { "operationId": "GetFile", "responses": { "200": { "description": "ok" } } }
+    }
+  }
+}`
+
+func TestRouter_Match(t *testing.T) {
+	document, err := libopenapi.NewDocument([]byte(routerSpec))
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	model, err := document.BuildV3Model()
+	if err != nil {
+		t.Fatalf("BuildV3Model() error = %v", err)
+	}
+	router := NewRouter(&model.Model)
+
+	tests := []struct {
+		method    string
+		path      string
+		operation string
+		params    map[string]string
+	}{
+		{"GET", "/v0.1/readers/current", "GetCurrentReader", map[string]string{}},
+		{"GET", "/v0.1/readers/rdr%2F1", "GetReader", map[string]string{"reader_id": "rdr/1"}},
+		{"delete", "/v0.1/readers/rdr_1/", "DeleteReader", map[string]string{"reader_id": "rdr_1"}},
+		{"GET", "/v0.1/files/report.pdf", "GetFile", map[string]string{"name": "report", "extension": "pdf"}},
+	}
+	for _, tt := range tests {
+		route, params, ok := router.Match(tt.method, tt.path)
+		if !ok {
+			t.Fatalf("Match(%q, %q) found no route", tt.method, tt.path)
+		}
+		if route.Operation.OperationId != tt.operation {
+			t.Fatalf("Match(%q, %q) = %q, want %q", tt.method, tt.path, route.Operation.OperationId, tt.operation)
+		}
+		for name, want := range tt.params {
+			if params[name] != want {
+				t.Fatalf("Match(%q, %q) param %s = %q, want %q", tt.method, tt.path, name, params[name], want)
+			}
+		}
+	}
+
+	if _, _, ok := router.Match("POST", "/v0.1/readers/rdr_1"); ok {
+		t.Fatalf("Match() matched an undocumented method")
+	}
+	if allowed := router.Allowed("/v0.1/readers/rdr_1"); len(allowed) != 2 || allowed[0] != "DELETE" || allowed[1] != "GET" {
+		t.Fatalf("Allowed() = %v, want [DELETE GET]", allowed)
+	}
+}
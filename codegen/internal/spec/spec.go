@@ -1,24 +1,57 @@
 package spec
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 )
 
-// Load returns the parsed OpenAPI specification at the given path.
-func Load(ctx context.Context, path string) (*v3.Document, error) {
-	_ = ctx
+// LoadOptions configures how Load reads a specification.
+type LoadOptions struct {
+	// Overlays lists OpenAPI Overlay documents, applied in order to the root
+	// document before the model is built.
+	Overlays []string
+	// Logger receives overlay warnings, such as targets matching no node,
+	// and the remote documents served from CacheDir because the server could
+	// not be reached. Warnings are dropped when nil.
+	Logger *log.Logger
+	// Stdin is read when the location is "-". Defaults to os.Stdin.
+	Stdin io.Reader
+	// HTTPClient fetches remote documents. Defaults to a client with a one
+	// minute timeout.
+	HTTPClient *http.Client
+	// CacheDir keeps remote documents together with their ETag. Cached
+	// documents are revalidated with If-None-Match and served when the
+	// network is unavailable or the server fails with a 5xx status. Caching
+	// is disabled when empty.
+	CacheDir string
+	// Offline serves remote documents from CacheDir without any request.
+	Offline bool
+}
 
-	data, err := os.ReadFile(path)
+// Load returns the parsed OpenAPI specification at location, which is a file
+// path, an http(s) URL or "-" for stdin. External $refs are resolved relative
+// to the location, so specifications split across files and URLs load as one
+// document. ctx bounds every remote fetch.
+func Load(ctx context.Context, location string, opts LoadOptions) (*v3.Document, error) {
+	data, config, err := read(ctx, location, opts)
 	if err != nil {
-		return nil, fmt.Errorf("read spec: %w", err)
+		return nil, err
 	}
 
-	doc, err := libopenapi.NewDocument(data)
+	doc, err := libopenapi.NewDocumentWithConfiguration(data, config)
 	if err != nil {
 		return nil, fmt.Errorf("create document: %w", err)
 	}
@@ -30,3 +63,94 @@ func Load(ctx context.Context, path string) (*v3.Document, error) {
 
 	return &model.Model, nil
 }
+
+// Read returns the root document at location with the overlays of opts
+// applied. Documents without overlays are returned unchanged; overlaid ones
+// are re-encoded as indented JSON or YAML, following the source format.
+func Read(ctx context.Context, location string, opts LoadOptions) ([]byte, error) {
+	data, _, err := read(ctx, location, opts)
+	return data, err
+}
+
+func read(ctx context.Context, location string, opts LoadOptions) ([]byte, *datamodel.DocumentConfiguration, error) {
+	fetcher := newFetcher(ctx, opts)
+	config := &datamodel.DocumentConfiguration{
+		AllowRemoteReferences: true,
+		RemoteURLHandler:      fetcher.handle,
+	}
+
+	data, err := fetcher.read(location, opts.Stdin)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case location == "-":
+		cwd, err := os.Getwd()
+		if err != nil {
+			return nil, nil, fmt.Errorf("resolve working directory: %w", err)
+		}
+		config.BasePath = cwd
+	case isRemote(location):
+		base, err := url.Parse(location)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse spec url: %w", err)
+		}
+		base.Path = path.Dir(base.Path)
+		base.RawQuery, base.Fragment = "", ""
+		config.BaseURL = base
+	default:
+		absolute, err := filepath.Abs(location)
+		if err != nil {
+			return nil, nil, fmt.Errorf("resolve spec path: %w", err)
+		}
+		config.BasePath = filepath.Dir(absolute)
+		config.SpecFilePath = filepath.Base(absolute)
+	}
+
+	if len(opts.Overlays) == 0 {
+		return data, config, nil
+	}
+	isJSON := bytes.HasPrefix(bytes.TrimSpace(data), []byte("{"))
+	for _, overlayLocation := range opts.Overlays {
+		overlay, err := fetcher.read(overlayLocation, opts.Stdin)
+		if err != nil {
+			return nil, nil, fmt.Errorf("overlay %s: %w", overlayLocation, err)
+		}
+		data, err = applyOverlay(data, overlay, overlayLocation, opts.Logger)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	// The overlay engine emits JSON sources as a single flow-style line;
+	// re-indent them so diagnostics point at meaningful lines.
+	if isJSON {
+		if data, err = ToJSON(data); err != nil {
+			return nil, nil, err
+		}
+	}
+	return data, config, nil
+}
+
+func isRemote(location string) bool {
+	lower := strings.ToLower(location)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// Pointer builds a JSON pointer fragment (e.g. "#/paths/~1v0.1~1me/get") from
+// unescaped reference tokens.
+func Pointer(tokens ...string) string {
+	return AppendPointer("#", tokens...)
+}
+
+// AppendPointer appends unescaped reference tokens to a JSON pointer.
+func AppendPointer(pointer string, tokens ...string) string {
+	var builder strings.Builder
+	builder.WriteString(pointer)
+	for _, token := range tokens {
+		builder.WriteString("/")
+		builder.WriteString(pointerEscaper.Replace(token))
+	}
+	return builder.String()
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
@@ -0,0 +1,210 @@
+package spec
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+const splitRootSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {
+    "/v0.1/widgets": {
+      "get": {
+        "operationId": "ListWidgets",
+        "responses": {
+          "200": {
+            "description": "ok",
+            "content": {
+              "application/json": {
+                "schema": { "$ref": "schemas/widget.json" }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const splitWidgetSchema = `{
+  "type": "object",
+  "properties": {
+    "id": { "type": "string" }
+  }
+}`
+
+func TestLoad_ResolvesReferencesAcrossFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "openapi.json"), splitRootSpec)
+	writeTestFile(t, filepath.Join(dir, "schemas", "widget.json"), splitWidgetSchema)
+
+	doc, err := Load(t.Context(), filepath.Join(dir, "openapi.json"), LoadOptions{})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	assertWidgetSchema(t, doc)
+}
+
+func TestLoad_ReadsStdin(t *testing.T) {
+	doc, err := Load(t.Context(), "-", LoadOptions{Stdin: strings.NewReader(routerSpec)})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if doc.Paths == nil || doc.Paths.PathItems.Len() != 3 {
+		t.Fatalf("Load() did not read the paths from stdin")
+	}
+}
+
+func TestLoad_FetchesAndCachesRemoteSpecs(t *testing.T) {
+	var (
+		mu           sync.Mutex
+		requests     []string
+		revalidated  int
+		files        = map[string]string{"/specs/openapi.json": splitRootSpec, "/specs/schemas/widget.json": splitWidgetSchema}
+		etagForPaths = map[string]string{"/specs/openapi.json": `"root-v1"`, "/specs/schemas/widget.json": `"widget-v1"`}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.URL.Path)
+		body, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		etag := etagForPaths[r.URL.Path]
+		if r.Header.Get("If-None-Match") == etag {
+			revalidated++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(body))
+	}))
+
+	cacheDir := t.TempDir()
+	location := server.URL + "/specs/openapi.json"
+	var logs strings.Builder
+	opts := LoadOptions{CacheDir: cacheDir, Logger: log.New(&logs, "", 0)}
+
+	doc, err := Load(t.Context(), location, opts)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	assertWidgetSchema(t, doc)
+	if len(requests) != 2 {
+		t.Fatalf("first load made %d requests (%v), want 2", len(requests), requests)
+	}
+
+	if _, err := Load(t.Context(), location, opts); err != nil {
+		t.Fatalf("second Load() error = %v", err)
+	}
+	if revalidated != 2 {
+		t.Fatalf("second load revalidated %d documents, want 2", revalidated)
+	}
+
+	server.Close()
+	doc, err = Load(t.Context(), location, opts)
+	if err != nil {
+		t.Fatalf("Load() with the server down error = %v", err)
+	}
+	assertWidgetSchema(t, doc)
+	if !strings.Contains(logs.String(), "fetch "+location+": ") || !strings.Contains(logs.String(), "using the copy cached") {
+		t.Fatalf("Load() with the server down did not warn about the cached copy of %s:\n%s", location, logs.String())
+	}
+
+	opts.Offline = true
+	if _, err := Load(t.Context(), location, opts); err != nil {
+		t.Fatalf("offline Load() error = %v", err)
+	}
+	if _, err := Load(t.Context(), server.URL+"/specs/other.json", opts); err == nil {
+		t.Fatalf("offline Load() of an uncached url succeeded")
+	}
+}
+
+func TestLoad_ServesCachedSpecsOnServerErrors(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(splitWidgetSchema))
+	}))
+	defer server.Close()
+
+	var logs strings.Builder
+	f := newFetcher(t.Context(), LoadOptions{CacheDir: t.TempDir(), Logger: log.New(&logs, "", 0)})
+	location := server.URL + "/widget.json"
+	if _, err := f.fetch(location); err != nil {
+		t.Fatalf("fetch() error = %v", err)
+	}
+
+	status = http.StatusBadGateway
+	body, err := f.fetch(location)
+	if err != nil {
+		t.Fatalf("fetch() with a 502 error = %v", err)
+	}
+	if string(body) != splitWidgetSchema {
+		t.Fatalf("fetch() with a 502 = %q, want the cached copy", body)
+	}
+	if !strings.Contains(logs.String(), "fetch "+location+": unexpected status 502 Bad Gateway; using the copy cached") {
+		t.Fatalf("fetch() with a 502 did not warn about the cached copy:\n%s", logs.String())
+	}
+
+	status = http.StatusNotFound
+	if _, err := f.fetch(location); err == nil {
+		t.Fatalf("fetch() with a 404 succeeded")
+	}
+}
+
+func TestLoad_HonorsContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if _, err := Load(ctx, server.URL+"/openapi.json", LoadOptions{}); err == nil {
+		t.Fatalf("Load() with a cancelled context succeeded")
+	}
+}
+
+func assertWidgetSchema(t *testing.T, doc *v3.Document) {
+	t.Helper()
+
+	pathItem := doc.Paths.PathItems.GetOrZero("/v0.1/widgets")
+	if pathItem == nil || pathItem.Get == nil {
+		t.Fatalf("document has no GET /v0.1/widgets")
+	}
+	media := pathItem.Get.Responses.Codes.GetOrZero("200").Content.GetOrZero("application/json")
+	schema := media.Schema.Schema()
+	if schema == nil {
+		t.Fatalf("external schema was not resolved: %v", media.Schema.GetBuildError())
+	}
+	if schema.Properties == nil || schema.Properties.GetOrZero("id") == nil {
+		t.Fatalf("external schema has no id property")
+	}
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+}
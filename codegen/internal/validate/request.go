@@ -0,0 +1,216 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+)
+
+// Request validates the parameters and body of a request matched to route.
+// pathParams holds the decoded path parameter values returned by the router.
+func Request(route *spec.Route, pathParams map[string]string, query url.Values, header http.Header, body []byte, opts Options) []Issue {
+	var issues []Issue
+	for _, parameter := range Parameters(route) {
+		var (
+			values  []string
+			present bool
+		)
+		switch parameter.In {
+		case "path":
+			var value string
+			value, present = pathParams[parameter.Name]
+			values = []string{value}
+		case "query":
+			values, present = query[parameter.Name]
+		case "header":
+			values, present = header[http.CanonicalHeaderKey(parameter.Name)]
+		default:
+			continue
+		}
+		location := parameter.In + "." + parameter.Name
+		if !present {
+			if parameter.Required != nil && *parameter.Required {
+				issues = append(issues, Issue{Location: location, Message: "missing required parameter"})
+			}
+			continue
+		}
+		issues = append(issues, Value(parameter.Schema, coerceParameter(parameter.Schema, values), location, opts)...)
+	}
+
+	if route.Operation.RequestBody == nil {
+		return issues
+	}
+	required := route.Operation.RequestBody.Required != nil && *route.Operation.RequestBody.Required
+	return append(issues, Body(route.Operation.RequestBody.Content, required, header.Get("Content-Type"), body, "body", opts)...)
+}
+
+// Body validates a payload against the media type matching contentType.
+func Body(content *orderedmap.Map[string, *v3.MediaType], required bool, contentType string, body []byte, location string, opts Options) []Issue {
+	if len(body) == 0 {
+		if required {
+			return []Issue{{Location: location, Message: "missing required body"}}
+		}
+		return nil
+	}
+	mediaType, ok := MediaType(content, contentType)
+	if !ok {
+		return []Issue{{Location: location, Message: "content type " + strconv.Quote(contentType) + " is not documented"}}
+	}
+	// Bodies without a content type are read as JSON.
+	if mediaType == nil || mediaType.Schema == nil || (contentType != "" && !spec.IsJSON(contentType)) {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return []Issue{{Location: location, Message: "invalid JSON: " + err.Error()}}
+	}
+	return Value(mediaType.Schema, value, location, opts)
+}
+
+// Response validates a response returned by the operation behind route.
+func Response(route *spec.Route, status int, header http.Header, body []byte, opts Options) []Issue {
+	response, ok := DocumentedResponse(route.Operation, status)
+	if !ok {
+		return []Issue{{Location: "response", Message: fmt.Sprintf("status %d is not documented", status)}}
+	}
+	if response.Content == nil || response.Content.Len() == 0 {
+		if len(body) > 0 {
+			return []Issue{{Location: "response.body", Message: "body is not documented"}}
+		}
+		return nil
+	}
+	return Body(response.Content, false, header.Get("Content-Type"), body, "response.body", opts)
+}
+
+// DocumentedResponse returns the response documented for status, checking the
+// exact code, its range (e.g. 4XX) and finally the default response.
+func DocumentedResponse(operation *v3.Operation, status int) (*v3.Response, bool) {
+	if operation == nil || operation.Responses == nil {
+		return nil, false
+	}
+	code := strconv.Itoa(status)
+	if operation.Responses.Codes != nil {
+		if response, ok := operation.Responses.Codes.Get(code); ok {
+			return response, true
+		}
+		if response, ok := operation.Responses.Codes.Get(code[:1] + "XX"); ok {
+			return response, true
+		}
+	}
+	if operation.Responses.Default != nil {
+		return operation.Responses.Default, true
+	}
+	return nil, false
+}
+
+// MediaType returns the documented media type serving contentType, falling
+// back to wildcard ranges. An empty contentType selects the first entry.
+func MediaType(content *orderedmap.Map[string, *v3.MediaType], contentType string) (*v3.MediaType, bool) {
+	if content == nil || content.Len() == 0 {
+		return nil, contentType == ""
+	}
+	if contentType == "" {
+		for _, mediaType := range content.FromOldest() {
+			return mediaType, true
+		}
+	}
+	base, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		base = strings.ToLower(strings.TrimSpace(contentType))
+	}
+	if mediaType, ok := content.Get(base); ok {
+		return mediaType, true
+	}
+	if slash := strings.Index(base, "/"); slash > 0 {
+		if mediaType, ok := content.Get(base[:slash] + "/*"); ok {
+			return mediaType, true
+		}
+	}
+	if mediaType, ok := content.Get("*/*"); ok {
+		return mediaType, true
+	}
+	return nil, false
+}
+
+// Parameters returns the operation parameters merged with the ones declared
+// on its path item, with operation-level declarations taking precedence.
+func Parameters(route *spec.Route) []*v3.Parameter {
+	merged := make([]*v3.Parameter, 0)
+	seen := map[string]struct{}{}
+	add := func(parameters []*v3.Parameter) {
+		for _, parameter := range parameters {
+			if parameter == nil {
+				continue
+			}
+			key := parameter.In + "." + parameter.Name
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			merged = append(merged, parameter)
+		}
+	}
+	add(route.Operation.Parameters)
+	if route.PathItem != nil {
+		add(route.PathItem.Parameters)
+	}
+	return merged
+}
+
+func coerceParameter(proxy *base.SchemaProxy, values []string) any {
+	var schema *base.Schema
+	if proxy != nil {
+		schema = proxy.Schema()
+	}
+	if schema != nil && hasType(schema.Type, "array") {
+		var items *base.SchemaProxy
+		if schema.Items != nil && schema.Items.IsA() {
+			items = schema.Items.A
+		}
+		if len(values) == 1 && strings.Contains(values[0], ",") {
+			values = strings.Split(values[0], ",")
+		}
+		result := make([]any, 0, len(values))
+		for _, value := range values {
+			result = append(result, coerceParameter(items, []string{value}))
+		}
+		return result
+	}
+	value := ""
+	if len(values) > 0 {
+		value = values[0]
+	}
+	if schema == nil {
+		return value
+	}
+	switch {
+	case hasType(schema.Type, "integer"), hasType(schema.Type, "number"):
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	case hasType(schema.Type, "boolean"):
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	}
+	return value
+}
+
+func hasType(types []string, target string) bool {
+	for _, t := range types {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,285 @@
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+)
+
+// Exchange is a single recorded HTTP request and its response.
+type Exchange struct {
+	Method         string
+	URL            *url.URL
+	RequestHeader  http.Header
+	RequestBody    []byte
+	Status         int
+	ResponseHeader http.Header
+	ResponseBody   []byte
+}
+
+// Result holds the issues found in one exchange.
+type Result struct {
+	// Index is the zero-based position of the exchange in its recording.
+	Index     int     `json:"index"`
+	Method    string  `json:"method"`
+	Path      string  `json:"path"`
+	Status    int     `json:"status"`
+	Operation string  `json:"operationId,omitempty"`
+	Issues    []Issue `json:"issues"`
+}
+
+// Validator checks recorded exchanges against a document.
+type Validator struct {
+	router    *spec.Router
+	basePaths []string
+	opts      Options
+}
+
+// New returns a validator for the document. Request paths are matched as-is
+// and, failing that, relative to the path of every documented server.
+func New(doc *v3.Document, opts Options) *Validator {
+	validator := &Validator{router: spec.NewRouter(doc), opts: opts}
+	if doc != nil {
+		for _, server := range doc.Servers {
+			if server == nil {
+				continue
+			}
+			parsed, err := url.Parse(server.URL)
+			if err != nil {
+				continue
+			}
+			if path := strings.TrimRight(parsed.Path, "/"); path != "" {
+				validator.basePaths = append(validator.basePaths, path)
+			}
+		}
+	}
+	return validator
+}
+
+// Exchange validates the request and response of a recorded exchange.
+func (v *Validator) Exchange(index int, exchange Exchange) Result {
+	result := Result{Index: index, Method: strings.ToUpper(exchange.Method), Status: exchange.Status}
+	if exchange.URL != nil {
+		result.Path = exchange.URL.EscapedPath()
+	}
+	route, pathParams, ok := v.match(result.Method, result.Path)
+	if !ok {
+		result.Issues = []Issue{{Location: "operation", Message: fmt.Sprintf("%s %s is not documented", result.Method, result.Path)}}
+		return result
+	}
+	result.Operation = route.Operation.OperationId
+
+	var query url.Values
+	if exchange.URL != nil {
+		query = exchange.URL.Query()
+	}
+	result.Issues = append(result.Issues, Request(route, pathParams, query, headerOrEmpty(exchange.RequestHeader), exchange.RequestBody, v.opts)...)
+	if exchange.Status != 0 {
+		result.Issues = append(result.Issues, Response(route, exchange.Status, headerOrEmpty(exchange.ResponseHeader), exchange.ResponseBody, v.opts)...)
+	}
+	return result
+}
+
+func (v *Validator) match(method, path string) (*spec.Route, map[string]string, bool) {
+	if route, params, ok := v.router.Match(method, path); ok {
+		return route, params, true
+	}
+	for _, basePath := range v.basePaths {
+		if trimmed, ok := strings.CutPrefix(path, basePath); ok {
+			if route, params, ok := v.router.Match(method, trimmed); ok {
+				return route, params, true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+func headerOrEmpty(header http.Header) http.Header {
+	if header == nil {
+		return http.Header{}
+	}
+	return header
+}
+
+// ReadExchanges parses a HAR archive or a JSON lines recording where every
+// line is an object shaped like
+//
+//	{"method": "GET", "url": "...", "request": {"headers": {}, "body": ...},
+//	 "response": {"status": 200, "headers": {}, "body": ...}}
+//
+// Bodies may be JSON values or strings holding the raw payload.
+func ReadExchanges(r io.Reader) ([]Exchange, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read traffic: %w", err)
+	}
+	var har harArchive
+	if err := json.Unmarshal(data, &har); err == nil && har.Log != nil {
+		return har.exchanges()
+	}
+	return readJSONLines(data)
+}
+
+type harArchive struct {
+	Log *struct {
+		Entries []struct {
+			Request struct {
+				Method   string      `json:"method"`
+				URL      string      `json:"url"`
+				Headers  []harHeader `json:"headers"`
+				PostData *struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+				} `json:"postData"`
+			} `json:"request"`
+			Response struct {
+				Status  int         `json:"status"`
+				Headers []harHeader `json:"headers"`
+				Content struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+					Encoding string `json:"encoding"`
+				} `json:"content"`
+			} `json:"response"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+type harHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (h harArchive) exchanges() ([]Exchange, error) {
+	exchanges := make([]Exchange, 0, len(h.Log.Entries))
+	for index, entry := range h.Log.Entries {
+		parsed, err := url.Parse(entry.Request.URL)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: parse url: %w", index, err)
+		}
+		exchange := Exchange{
+			Method:         entry.Request.Method,
+			URL:            parsed,
+			RequestHeader:  harHeaders(entry.Request.Headers),
+			Status:         entry.Response.Status,
+			ResponseHeader: harHeaders(entry.Response.Headers),
+			ResponseBody:   []byte(entry.Response.Content.Text),
+		}
+		if entry.Request.PostData != nil {
+			exchange.RequestBody = []byte(entry.Request.PostData.Text)
+			if exchange.RequestHeader.Get("Content-Type") == "" && entry.Request.PostData.MimeType != "" {
+				exchange.RequestHeader.Set("Content-Type", entry.Request.PostData.MimeType)
+			}
+		}
+		if entry.Response.Content.Encoding == "base64" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Response.Content.Text)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: decode response body: %w", index, err)
+			}
+			exchange.ResponseBody = decoded
+		}
+		if exchange.ResponseHeader.Get("Content-Type") == "" && entry.Response.Content.MimeType != "" {
+			exchange.ResponseHeader.Set("Content-Type", entry.Response.Content.MimeType)
+		}
+		exchanges = append(exchanges, exchange)
+	}
+	return exchanges, nil
+}
+
+func harHeaders(headers []harHeader) http.Header {
+	result := http.Header{}
+	for _, header := range headers {
+		result.Add(header.Name, header.Value)
+	}
+	return result
+}
+
+type jsonLine struct {
+	Method  string `json:"method"`
+	URL     string `json:"url"`
+	Request struct {
+		Headers map[string]string `json:"headers"`
+		Body    json.RawMessage   `json:"body"`
+	} `json:"request"`
+	Response struct {
+		Status  int               `json:"status"`
+		Headers map[string]string `json:"headers"`
+		Body    json.RawMessage   `json:"body"`
+	} `json:"response"`
+}
+
+func readJSONLines(data []byte) ([]Exchange, error) {
+	var exchanges []Exchange
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var entry jsonLine
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		parsed, err := url.Parse(entry.URL)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: parse url: %w", line, err)
+		}
+		requestBody, err := rawBody(entry.Request.Body)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: request body: %w", line, err)
+		}
+		responseBody, err := rawBody(entry.Response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: response body: %w", line, err)
+		}
+		exchanges = append(exchanges, Exchange{
+			Method:         entry.Method,
+			URL:            parsed,
+			RequestHeader:  mapHeaders(entry.Request.Headers),
+			RequestBody:    requestBody,
+			Status:         entry.Response.Status,
+			ResponseHeader: mapHeaders(entry.Response.Headers),
+			ResponseBody:   responseBody,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read traffic: %w", err)
+	}
+	return exchanges, nil
+}
+
+// rawBody unwraps bodies recorded as JSON strings and keeps JSON values as-is.
+func rawBody(raw json.RawMessage) ([]byte, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	if raw[0] != '"' {
+		return raw, nil
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
+
+func mapHeaders(headers map[string]string) http.Header {
+	result := http.Header{}
+	for name, value := range headers {
+		result.Set(name, value)
+	}
+	return result
+}
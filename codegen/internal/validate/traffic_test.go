@@ -0,0 +1,122 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+)
+
+const trafficSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "servers": [{ "url": "https://api.example.com/api" }],
+  "paths": {
+    "/v0.1/readers/{reader_id}": {
+      "patch": {
+        "operationId": "UpdateReader",
+        "parameters": [
+          { "name": "reader_id", "in": "path", "required": true, "schema": { "type": "string", "minLength": 4 } },
+          { "name": "limit", "in": "query", "schema": { "type": "integer" } }
+        ],
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": { "type": "object", "properties": { "name": { "type": "string" } } }
+            }
+          }
+        },
+        "responses": {
+          "200": {
+            "description": "ok",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "allOf": [
+                    { "type": "object", "required": ["id"], "properties": { "id": { "type": "string" } } },
+                    { "type": "object", "properties": { "name": { "type": "string" } } }
+                  ]
+                }
+              }
+            }
+          },
+          "4XX": { "description": "client error" }
+        }
+      }
+    }
+  }
+}`
+
+func TestValidator_Exchange(t *testing.T) {
+	document, err := libopenapi.NewDocument([]byte(trafficSpec))
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	model, err := document.BuildV3Model()
+	if err != nil {
+		t.Fatalf("BuildV3Model() error = %v", err)
+	}
+	validator := New(&model.Model, Options{Undocumented: true})
+
+	recording := strings.Join([]string{
+		`{"method":"PATCH","url":"https://api.example.com/api/v0.1/readers/rdr_1","request":{"headers":{"Content-Type":"application/json"},"body":{"name":"Front desk"}},"response":{"status":200,"headers":{"Content-Type":"application/json"},"body":"{\"id\":\"rdr_1\",\"name\":\"Front desk\"}"}}`,
+		`{"method":"PATCH","url":"https://api.example.com/v0.1/readers/rdr?limit=ten","request":{"body":{"name":1}},"response":{"status":200,"headers":{"Content-Type":"application/json"},"body":{"name":"x","paired":true}}}`,
+		`{"method":"PATCH","url":"https://api.example.com/v0.1/readers/rdr_1","response":{"status":409}}`,
+		`{"method":"DELETE","url":"https://api.example.com/v0.1/readers/rdr_1","response":{"status":204}}`,
+	}, "\n")
+	exchanges, err := ReadExchanges(strings.NewReader(recording))
+	if err != nil {
+		t.Fatalf("ReadExchanges() error = %v", err)
+	}
+
+	want := [][]string{
+		nil,
+		{
+			"path.reader_id: expected at least 4 characters, got 3",
+			`query.limit: expected integer, got string`,
+			"body/name: expected string, got number",
+			`response.body: missing required property "id"`,
+			`response.body/paired: property "paired" is not documented`,
+		},
+		nil,
+		{"operation: DELETE /v0.1/readers/rdr_1 is not documented"},
+	}
+	if len(exchanges) != len(want) {
+		t.Fatalf("ReadExchanges() returned %d exchanges, want %d", len(exchanges), len(want))
+	}
+	for index, exchange := range exchanges {
+		result := validator.Exchange(index, exchange)
+		got := make([]string, 0, len(result.Issues))
+		for _, issue := range result.Issues {
+			got = append(got, issue.String())
+		}
+		if strings.Join(got, "\n") != strings.Join(want[index], "\n") {
+			t.Fatalf("exchange %d issues = %q, want %q", index, got, want[index])
+		}
+	}
+}
+
+func TestReadExchanges_HAR(t *testing.T) {
+	har := `{"log":{"entries":[{
+	  "request":{"method":"POST","url":"https://api.example.com/v0.1/readers","headers":[],"postData":{"mimeType":"application/json","text":"{\"name\":\"x\"}"}},
+	  "response":{"status":201,"headers":[{"name":"Content-Type","value":"application/json"}],"content":{"mimeType":"application/json","text":"eyJpZCI6InJkcl8xIn0=","encoding":"base64"}}
+	}]}}`
+
+	exchanges, err := ReadExchanges(strings.NewReader(har))
+	if err != nil {
+		t.Fatalf("ReadExchanges() error = %v", err)
+	}
+	if len(exchanges) != 1 {
+		t.Fatalf("ReadExchanges() returned %d exchanges, want 1", len(exchanges))
+	}
+	exchange := exchanges[0]
+	if exchange.Method != "POST" || exchange.URL.Path != "/v0.1/readers" || exchange.Status != 201 {
+		t.Fatalf("exchange = %+v", exchange)
+	}
+	if got := exchange.RequestHeader.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("request content type = %q, want %q", got, "application/json")
+	}
+	if got := string(exchange.ResponseBody); got != `{"id":"rdr_1"}` {
+		t.Fatalf("response body = %q, want decoded base64", got)
+	}
+}
@@ -0,0 +1,390 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/examples"
+)
+
+const maxDepth = 32
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Issue describes a single value that does not match the specification.
+type Issue struct {
+	// Location is the part of the exchange holding the value, e.g. "body",
+	// "query.limit" or "path.merchant_code".
+	Location string `json:"location"`
+	// Pointer is the JSON pointer of the value within the location.
+	Pointer string `json:"pointer,omitempty"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string {
+	if i.Pointer == "" {
+		return fmt.Sprintf("%s: %s", i.Location, i.Message)
+	}
+	return fmt.Sprintf("%s%s: %s", i.Location, i.Pointer, i.Message)
+}
+
+// Options tunes how strictly values are checked.
+type Options struct {
+	// Undocumented reports object properties that are not declared by the
+	// schema when it does not say anything about additionalProperties.
+	Undocumented bool
+}
+
+// Value validates a decoded JSON value against the schema and returns every
+// mismatch found. Numbers are expected as float64 or json.Number.
+func Value(proxy *base.SchemaProxy, value any, location string, opts Options) []Issue {
+	v := &validator{location: location, opts: opts}
+	v.value(proxy, value, "", 0, false)
+	return v.issues
+}
+
+type validator struct {
+	location string
+	opts     Options
+	issues   []Issue
+}
+
+func (v *validator) fail(pointer, format string, args ...any) {
+	v.issues = append(v.issues, Issue{Location: v.location, Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+// value validates value against proxy. composed is set while checking one part
+// of an allOf, whose siblings may declare the remaining properties.
+func (v *validator) value(proxy *base.SchemaProxy, value any, pointer string, depth int, composed bool) {
+	if proxy == nil || depth > maxDepth {
+		return
+	}
+	schema := proxy.Schema()
+	if schema == nil {
+		return
+	}
+
+	if value == nil {
+		if !nullable(schema) {
+			v.fail(pointer, "null is not allowed")
+		}
+		return
+	}
+
+	for _, part := range schema.AllOf {
+		v.value(part, value, pointer, depth+1, true)
+	}
+	if len(schema.OneOf) > 0 && !v.matchesAny(schema.OneOf, value, pointer, depth) {
+		v.fail(pointer, "value does not match any oneOf schema")
+	}
+	if len(schema.AnyOf) > 0 && !v.matchesAny(schema.AnyOf, value, pointer, depth) {
+		v.fail(pointer, "value does not match any anyOf schema")
+	}
+
+	if len(schema.Enum) > 0 && !inEnum(schema.Enum, value) {
+		v.fail(pointer, "value %s is not one of the allowed values", describe(value))
+		return
+	}
+
+	if len(schema.Type) > 0 && !matchesType(schema.Type, value) {
+		v.fail(pointer, "expected %s, got %s", strings.Join(nonNullTypes(schema.Type), " or "), kindOf(value))
+		return
+	}
+
+	switch typed := value.(type) {
+	case map[string]any:
+		v.object(schema, typed, pointer, depth, composed)
+	case []any:
+		v.array(schema, typed, pointer, depth)
+	case string:
+		v.string(schema, typed, pointer)
+	case float64, json.Number:
+		v.number(schema, toFloat(typed), pointer)
+	}
+}
+
+func (v *validator) matchesAny(candidates []*base.SchemaProxy, value any, pointer string, depth int) bool {
+	for _, candidate := range candidates {
+		probe := &validator{location: v.location}
+		probe.value(candidate, value, pointer, depth+1, true)
+		if len(probe.issues) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validator) object(schema *base.Schema, value map[string]any, pointer string, depth int, composed bool) {
+	for _, name := range schema.Required {
+		if _, ok := value[name]; !ok {
+			v.fail(pointer, "missing required property %q", name)
+		}
+	}
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		child := pointer + "/" + escapePointer(name)
+		if schema.Properties != nil {
+			if property, ok := schema.Properties.Get(name); ok {
+				v.value(property, value[name], child, depth+1, false)
+				continue
+			}
+		}
+		if schema.AdditionalProperties == nil {
+			if v.opts.Undocumented && !composed && !declares(schema, name, 0) {
+				v.fail(child, "property %q is not documented", name)
+			}
+			continue
+		}
+		if schema.AdditionalProperties.IsB() {
+			if !schema.AdditionalProperties.B {
+				v.fail(child, "property %q is not allowed", name)
+			}
+			continue
+		}
+		v.value(schema.AdditionalProperties.A, value[name], child, depth+1, false)
+	}
+}
+
+func (v *validator) array(schema *base.Schema, value []any, pointer string, depth int) {
+	if schema.MinItems != nil && int64(len(value)) < *schema.MinItems {
+		v.fail(pointer, "expected at least %d items, got %d", *schema.MinItems, len(value))
+	}
+	if schema.MaxItems != nil && int64(len(value)) > *schema.MaxItems {
+		v.fail(pointer, "expected at most %d items, got %d", *schema.MaxItems, len(value))
+	}
+	if schema.Items == nil || !schema.Items.IsA() {
+		return
+	}
+	for index, item := range value {
+		v.value(schema.Items.A, item, pointer+"/"+strconv.Itoa(index), depth+1, false)
+	}
+}
+
+func (v *validator) string(schema *base.Schema, value string, pointer string) {
+	length := int64(len([]rune(value)))
+	if schema.MinLength != nil && length < *schema.MinLength {
+		v.fail(pointer, "expected at least %d characters, got %d", *schema.MinLength, length)
+	}
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		v.fail(pointer, "expected at most %d characters, got %d", *schema.MaxLength, length)
+	}
+	if schema.Pattern != "" {
+		if pattern, err := regexp.Compile(schema.Pattern); err == nil && !pattern.MatchString(value) {
+			v.fail(pointer, "value %q does not match pattern %q", value, schema.Pattern)
+		}
+	}
+	if !matchesFormat(schema.Format, value) {
+		v.fail(pointer, "value %q is not a valid %s", value, schema.Format)
+	}
+}
+
+func (v *validator) number(schema *base.Schema, value float64, pointer string) {
+	if schema.Minimum != nil && value < *schema.Minimum {
+		v.fail(pointer, "expected a value >= %v, got %v", *schema.Minimum, value)
+	}
+	if schema.Maximum != nil && value > *schema.Maximum {
+		v.fail(pointer, "expected a value <= %v, got %v", *schema.Maximum, value)
+	}
+}
+
+// declares reports whether the schema or any schema it is composed of accepts
+// the property, either explicitly or through additionalProperties.
+func declares(schema *base.Schema, name string, depth int) bool {
+	if schema == nil || depth > maxDepth {
+		return false
+	}
+	if schema.AdditionalProperties != nil {
+		return true
+	}
+	if schema.Properties != nil {
+		if _, ok := schema.Properties.Get(name); ok {
+			return true
+		}
+	}
+	composed := len(schema.AllOf) + len(schema.OneOf) + len(schema.AnyOf)
+	if schema.Properties == nil && composed == 0 {
+		// A bare object schema documents a free-form map.
+		return true
+	}
+	for _, group := range [][]*base.SchemaProxy{schema.AllOf, schema.OneOf, schema.AnyOf} {
+		for _, part := range group {
+			if part != nil && declares(part.Schema(), name, depth+1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesFormat(format, value string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case "date":
+		_, err := time.Parse(time.DateOnly, value)
+		return err == nil
+	case "uuid":
+		return uuidPattern.MatchString(value)
+	case "email":
+		_, err := mail.ParseAddress(value)
+		return err == nil
+	default:
+		return true
+	}
+}
+
+func matchesType(types []string, value any) bool {
+	for _, t := range types {
+		switch t {
+		case "object":
+			if _, ok := value.(map[string]any); ok {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]any); ok {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "number":
+			if isNumber(value) {
+				return true
+			}
+		case "integer":
+			if isNumber(value) {
+				f := toFloat(value)
+				if f == math.Trunc(f) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func nullable(schema *base.Schema) bool {
+	if schema.Nullable != nil && *schema.Nullable {
+		return true
+	}
+	for _, t := range schema.Type {
+		if t == "null" {
+			return true
+		}
+	}
+	for _, group := range [][]*base.SchemaProxy{schema.OneOf, schema.AnyOf} {
+		for _, candidate := range group {
+			if s := candidate.Schema(); s != nil && nullable(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func inEnum(values []*yaml.Node, value any) bool {
+	for _, node := range values {
+		allowed, ok := examples.Decode(node)
+		if !ok {
+			continue
+		}
+		if equal(allowed, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func equal(expected, actual any) bool {
+	if isNumber(expected) && isNumber(actual) {
+		return toFloat(expected) == toFloat(actual)
+	}
+	return reflect.DeepEqual(expected, actual)
+}
+
+func isNumber(value any) bool {
+	switch value.(type) {
+	case float64, float32, int, int64, json.Number:
+		return true
+	}
+	return false
+}
+
+func toFloat(value any) float64 {
+	switch typed := value.(type) {
+	case float64:
+		return typed
+	case float32:
+		return float64(typed)
+	case int:
+		return float64(typed)
+	case int64:
+		return float64(typed)
+	case json.Number:
+		f, _ := typed.Float64()
+		return f
+	}
+	return 0
+}
+
+func nonNullTypes(types []string) []string {
+	result := make([]string, 0, len(types))
+	for _, t := range types {
+		if t != "null" {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func kindOf(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	}
+	if isNumber(value) {
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func describe(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
+
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
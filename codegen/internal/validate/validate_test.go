@@ -0,0 +1,88 @@
+package validate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+const validateSpec = `{
+  "openapi": "3.0.3",
+  "info": { "title": "test", "version": "1.0.0" },
+  "paths": {},
+  "components": {
+    "schemas": {
+      "Checkout": {
+        "type": "object",
+        "required": ["amount", "currency"],
+        "additionalProperties": false,
+        "properties": {
+          "amount": { "type": "number", "minimum": 0 },
+          "currency": { "type": "string", "enum": ["EUR", "GBP"] },
+          "valid_until": { "type": "string", "format": "date-time", "nullable": true },
+          "items": { "type": "array", "items": { "type": "integer" } },
+          "customer": {
+            "oneOf": [
+              { "type": "string", "minLength": 3 },
+              { "type": "object", "required": ["id"], "properties": { "id": { "type": "string" } } }
+            ]
+          }
+        }
+      }
+    }
+  }
+}`
+
+func TestValue(t *testing.T) {
+	schema := mustSchema(t, "Checkout")
+
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{"valid", `{"amount":10.5,"currency":"EUR","valid_until":null,"items":[1,2],"customer":{"id":"c1"}}`, nil},
+		{"missing required", `{"amount":1}`, []string{`body: missing required property "currency"`}},
+		{"enum", `{"amount":1,"currency":"USD"}`, []string{`body/currency: value "USD" is not one of the allowed values`}},
+		{"minimum", `{"amount":-1,"currency":"EUR"}`, []string{"body/amount: expected a value >= 0, got -1"}},
+		{"format", `{"amount":1,"currency":"EUR","valid_until":"tomorrow"}`, []string{`body/valid_until: value "tomorrow" is not a valid date-time`}},
+		{"integer items", `{"amount":1,"currency":"EUR","items":[1,1.5]}`, []string{"body/items/1: expected integer, got number"}},
+		{"additional", `{"amount":1,"currency":"EUR","extra":true}`, []string{`body/extra: property "extra" is not allowed`}},
+		{"oneOf", `{"amount":1,"currency":"EUR","customer":"ab"}`, []string{"body/customer: value does not match any oneOf schema"}},
+	}
+	for _, tt := range tests {
+		var value any
+		if err := json.Unmarshal([]byte(tt.value), &value); err != nil {
+			t.Fatalf("%s: unmarshal: %v", tt.name, err)
+		}
+		issues := Value(schema, value, "body", Options{})
+		got := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			got = append(got, issue.String())
+		}
+		if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+			t.Fatalf("%s: Value() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func mustSchema(t *testing.T, name string) *base.SchemaProxy {
+	t.Helper()
+
+	document, err := libopenapi.NewDocument([]byte(validateSpec))
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	model, err := document.BuildV3Model()
+	if err != nil {
+		t.Fatalf("BuildV3Model() error = %v", err)
+	}
+	schema, ok := model.Model.Components.Schemas.Get(name)
+	if !ok {
+		t.Fatalf("schema %q not found", name)
+	}
+	return schema
+}
@@ -1,21 +1,35 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"log"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"syscall"
+	"time"
 
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 
+	"github.com/sumup/sumup-dotnet/codegen/internal/apidiff"
+	"github.com/sumup/sumup-dotnet/codegen/internal/config"
+	"github.com/sumup/sumup-dotnet/codegen/internal/diag"
 	"github.com/sumup/sumup-dotnet/codegen/internal/generator"
+	"github.com/sumup/sumup-dotnet/codegen/internal/lint"
+	"github.com/sumup/sumup-dotnet/codegen/internal/mock"
 	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+	"github.com/sumup/sumup-dotnet/codegen/internal/validate"
 )
 
 var sdkVersionPattern = regexp.MustCompile(`<Version>\s*([^<]+?)\s*</Version>`)
@@ -28,8 +42,29 @@ func main() {
 }
 
 func run(args []string, stdout io.Writer) error {
-	if len(args) > 0 && args[0] == "samples" {
-		return runSamples(args[1:], stdout)
+	if len(args) > 0 {
+		switch args[0] {
+		case "samples":
+			return runSamples(args[1:], stdout)
+		case "mock":
+			return runMock(args[1:], stdout)
+		case "validate-traffic":
+			return runValidateTraffic(args[1:], stdout)
+		case "lint":
+			return runLint(args[1:], stdout)
+		case "overlay":
+			return runOverlay(args[1:], stdout)
+		case "diff":
+			return runDiff(args[1:], stdout)
+		case "changelog":
+			return runChangelog(args[1:], stdout)
+		case "graph":
+			return runGraph(args[1:], stdout)
+		case "templates":
+			return runTemplates(args[1:], stdout)
+		case "ir":
+			return runIR(args[1:], stdout)
+		}
 	}
 	return runSDK(args, stdout)
 }
@@ -37,18 +72,34 @@ func run(args []string, stdout io.Writer) error {
 func runSDK(args []string, stdout io.Writer) error {
 	flags := flag.NewFlagSet("codegen", flag.ContinueOnError)
 	flags.SetOutput(stdout)
-	var specPath, output, namespace string
-	flags.StringVar(&specPath, "spec", "", "Path to the OpenAPI specification (JSON or YAML).")
+	var configPath, specPath, output, testingOutput, namespace, templatesDir, diagnosticsJSON string
+	var strict, prune bool
+	loadOptions := specFlags(flags, &specPath)
+	flags.StringVar(&configPath, "config", "", "Path to a codegen.yaml configuration; flags override its settings.")
 	flags.StringVar(&output, "output", "src/SumUp", "Directory where generated files will be written.")
+	flags.StringVar(&testingOutput, "testing-output", "", "Directory where the SumUp.Testing fake routes will be written (skipped when empty).")
 	flags.StringVar(&namespace, "namespace", "SumUp", "Root namespace for generated code.")
+	flags.StringVar(&templatesDir, "templates", "", "Directory of templates overriding the embedded ones (see TEMPLATES.md).")
+	flags.BoolVar(&strict, "strict", false, "Fail when generation reports warnings.")
+	flags.StringVar(&diagnosticsJSON, "diagnostics-json", "", "Path where generation diagnostics are written as JSON.")
+	flags.BoolVar(&prune, "prune-unreferenced", false, "Skip component schemas no operation references.")
+	filter := filterFlags(flags)
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
+	cfg, err := loadConfig(flags, configPath, &specPath, loadOptions)
+	if err != nil {
+		return err
+	}
+	configure(flags, "output", &output, cfg.Output)
+	configure(flags, "testing-output", &testingOutput, cfg.TestingOutput)
+	configure(flags, "namespace", &namespace, cfg.Namespace)
+	configure(flags, "templates", &templatesDir, cfg.Templates)
 	if specPath == "" {
 		return fmt.Errorf("spec path is required (pass --spec)")
 	}
 
-	doc, err := loadSpec(specPath)
+	doc, err := loadSpec(specPath, *loadOptions)
 	if err != nil {
 		return err
 	}
@@ -56,26 +107,174 @@ func runSDK(args []string, stdout io.Writer) error {
 	if err != nil {
 		return err
 	}
-	gen := generator.New(generator.Config{OutputDir: outputDir, Namespace: namespace})
+	testingDir := ""
+	if testingOutput != "" {
+		testingDir, err = absolutePath(testingOutput)
+		if err != nil {
+			return err
+		}
+	}
+	genConfig := cfg.Generator()
+	genConfig.OutputDir, genConfig.Namespace, genConfig.TestingOutputDir = outputDir, namespace, testingDir
+	genConfig.TemplatesDir = templatesDir
+	applyFilterFlags(flags, filter, &genConfig)
+	if flagSet(flags, "prune-unreferenced") {
+		genConfig.PruneUnreferenced = prune
+	}
+	gen := generator.New(genConfig)
 	if err := gen.Run(doc); err != nil {
-		return fmt.Errorf("generate: %w", err)
+		return specFailure("generate", specPath, err)
 	}
-	_, err = fmt.Fprintf(stdout, "Generated SDK files at %s\n", outputDir)
-	return err
+	diagnostics := gen.Diagnostics()
+	if diagnosticsJSON != "" {
+		if err := writeDiagnostics(diagnosticsJSON, diagnostics); err != nil {
+			return err
+		}
+	}
+	for _, diagnostic := range diagnostics {
+		if _, err := fmt.Fprintln(stdout, diagnostic); err != nil {
+			return err
+		}
+	}
+	warnings := diag.Count(diagnostics, diag.Warning)
+	if _, err := fmt.Fprintf(stdout, "Generated SDK files at %s (%d warnings)\n", outputDir, warnings); err != nil {
+		return err
+	}
+	if strict && warnings > 0 {
+		return fmt.Errorf("generate: %d warnings in strict mode", warnings)
+	}
+	return nil
+}
+
+// loadConfig reads the configuration at path, when set, and applies its spec
+// and overlays unless --spec or --overlay were passed.
+func loadConfig(flags *flag.FlagSet, path string, specPath *string, loadOptions *spec.LoadOptions) (*config.Config, error) {
+	if path == "" {
+		return &config.Config{}, nil
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	configure(flags, "spec", specPath, cfg.Spec)
+	if !flagSet(flags, "overlay") {
+		loadOptions.Overlays = cfg.Overlays
+	}
+	return cfg, nil
+}
+
+// filterFlagNames lists the flags registered by filterFlags.
+var filterFlagNames = []string{"include-tag", "exclude-tag", "include-operation", "exclude-operation", "include-path", "exclude-path"}
+
+// filterFlags registers the repeatable flags selecting the operations to
+// generate.
+func filterFlags(flags *flag.FlagSet) *generator.Filter {
+	filter := &generator.Filter{}
+	for _, f := range []struct {
+		name, usage string
+		values      *[]string
+		glob        bool
+	}{
+		{"include-tag", "Only generate operations with this tag (repeatable).", &filter.Include.Tags, false},
+		{"exclude-tag", "Skip operations with this tag (repeatable).", &filter.Exclude.Tags, false},
+		{"include-operation", "Only generate operations whose operationId matches this glob (repeatable).", &filter.Include.Operations, true},
+		{"exclude-operation", "Skip operations whose operationId matches this glob (repeatable).", &filter.Exclude.Operations, true},
+		{"include-path", "Only generate operations under this path prefix (repeatable).", &filter.Include.Paths, false},
+		{"exclude-path", "Skip operations under this path prefix (repeatable).", &filter.Exclude.Paths, false},
+	} {
+		flags.Func(f.name, f.usage, func(value string) error {
+			if f.glob {
+				if _, err := path.Match(value, ""); err != nil {
+					return fmt.Errorf("invalid glob %q", value)
+				}
+			}
+			*f.values = append(*f.values, value)
+			return nil
+		})
+	}
+	return filter
+}
+
+// applyFilterFlags replaces the configured filter with the one given on the
+// command line, if any.
+func applyFilterFlags(flags *flag.FlagSet, filter *generator.Filter, config *generator.Config) {
+	for _, name := range filterFlagNames {
+		if flagSet(flags, name) {
+			config.Filter = *filter
+			return
+		}
+	}
+}
+
+// configure sets the value of the named flag from the configuration unless it
+// was passed on the command line.
+func configure(flags *flag.FlagSet, name string, value *string, configured string) {
+	if configured != "" && !flagSet(flags, name) {
+		*value = configured
+	}
+}
+
+func flagSet(flags *flag.FlagSet, name string) bool {
+	set := false
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+func writeDiagnostics(path string, diagnostics []diag.Diagnostic) error {
+	if diagnostics == nil {
+		diagnostics = []diag.Diagnostic{}
+	}
+	encoded, err := json.MarshalIndent(diagnostics, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode diagnostics: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create diagnostics directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(encoded, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write diagnostics: %w", err)
+	}
+	return nil
+}
+
+// specFailure names the spec file in errors caused by one of its constructs,
+// whose message already carries the pointer, line and column.
+func specFailure(action, specPath string, err error) error {
+	var specErr *diag.SpecError
+	if errors.As(err, &specErr) {
+		return fmt.Errorf("%s: %s: %w", action, specPath, err)
+	}
+	return fmt.Errorf("%s: %w", action, err)
 }
 
 func runSamples(args []string, stdout io.Writer) error {
 	flags := flag.NewFlagSet("codegen samples", flag.ContinueOnError)
 	flags.SetOutput(stdout)
-	var specPath, output, sdkVersion, sdkVersionFile, namespace string
-	flags.StringVar(&specPath, "spec", "", "Path to the OpenAPI specification (JSON or YAML).")
+	var configPath, specPath, output, sdkVersion, sdkVersionFile, namespace string
+	var prune bool
+	loadOptions := specFlags(flags, &specPath)
+	flags.StringVar(&configPath, "config", "", "Path to a codegen.yaml configuration; flags override its settings.")
 	flags.StringVar(&output, "output", "", "Path to the output JSON file (defaults to stdout).")
 	flags.StringVar(&sdkVersion, "sdk-version", "", "SumUp .NET SDK version represented by the samples.")
 	flags.StringVar(&sdkVersionFile, "sdk-version-file", "", "MSBuild project containing the SDK Version property.")
 	flags.StringVar(&namespace, "namespace", "SumUp", "Root namespace used by generated samples.")
+	flags.BoolVar(&prune, "prune-unreferenced", false, "Skip component schemas no operation references.")
+	filter := filterFlags(flags)
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
+	cfg, err := loadConfig(flags, configPath, &specPath, loadOptions)
+	if err != nil {
+		return err
+	}
+	configure(flags, "namespace", &namespace, cfg.Namespace)
+	if !flagSet(flags, "sdk-version") && !flagSet(flags, "sdk-version-file") {
+		sdkVersion, sdkVersionFile = cfg.Samples.SDKVersion, cfg.Samples.SDKVersionFile
+	}
 	if specPath == "" {
 		return fmt.Errorf("spec path is required (pass --spec)")
 	}
@@ -90,14 +289,20 @@ func runSamples(args []string, stdout io.Writer) error {
 		return fmt.Errorf("sdk version is required (pass --sdk-version or --sdk-version-file)")
 	}
 
-	doc, err := loadSpec(specPath)
+	doc, err := loadSpec(specPath, *loadOptions)
 	if err != nil {
 		return err
 	}
-	gen := generator.New(generator.Config{Namespace: namespace})
+	genConfig := cfg.Generator()
+	genConfig.OutputDir, genConfig.TestingOutputDir, genConfig.Namespace = "", "", namespace
+	applyFilterFlags(flags, filter, &genConfig)
+	if flagSet(flags, "prune-unreferenced") {
+		genConfig.PruneUnreferenced = prune
+	}
+	gen := generator.New(genConfig)
 	catalog, err := gen.Samples(doc, sdkVersion)
 	if err != nil {
-		return fmt.Errorf("generate samples: %w", err)
+		return specFailure("generate samples", specPath, err)
 	}
 	encoded, err := json.MarshalIndent(catalog, "", "  ")
 	if err != nil {
@@ -117,12 +322,596 @@ func runSamples(args []string, stdout io.Writer) error {
 	return nil
 }
 
-func loadSpec(path string) (*v3.Document, error) {
-	absSpec, err := filepath.Abs(path)
+func runIR(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("codegen ir", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	var configPath, specPath, output, namespace string
+	var operations []string
+	loadOptions := specFlags(flags, &specPath)
+	flags.StringVar(&configPath, "config", "", "Path to a codegen.yaml configuration; flags override its settings.")
+	flags.StringVar(&output, "output", "", "Path to the output JSON file (defaults to stdout).")
+	flags.StringVar(&namespace, "namespace", "SumUp", "Root namespace for generated code.")
+	flags.Func("operation", "Only dump operations whose operationId matches this glob, and the models they use (repeatable).", func(value string) error {
+		if _, err := path.Match(value, ""); err != nil {
+			return fmt.Errorf("invalid glob %q", value)
+		}
+		operations = append(operations, value)
+		return nil
+	})
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	cfg, err := loadConfig(flags, configPath, &specPath, loadOptions)
+	if err != nil {
+		return err
+	}
+	configure(flags, "namespace", &namespace, cfg.Namespace)
+	if specPath == "" {
+		return fmt.Errorf("spec path is required (pass --spec)")
+	}
+
+	doc, err := loadSpec(specPath, *loadOptions)
+	if err != nil {
+		return err
+	}
+	genConfig := cfg.Generator()
+	genConfig.OutputDir, genConfig.TestingOutputDir, genConfig.Namespace = "", "", namespace
+	if len(operations) > 0 {
+		genConfig.Filter.Include = generator.Selector{Operations: operations}
+	}
+	api, err := generator.New(genConfig).IR(doc)
+	if errors.Is(err, generator.ErrNoOperations) && len(operations) > 0 {
+		return fmt.Errorf("ir: no operation matches --operation %s", strings.Join(operations, ", "))
+	}
+	if err != nil {
+		return specFailure("ir", specPath, err)
+	}
+	encoded, err := json.MarshalIndent(api, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode ir: %w", err)
+	}
+	return writeOutput(stdout, output, append(encoded, '\n'))
+}
+
+func runMock(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("codegen mock", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	var specPath, addr string
+	var quiet bool
+	loadOptions := specFlags(flags, &specPath)
+	flags.StringVar(&addr, "addr", ":4010", "Address the mock server listens on.")
+	flags.BoolVar(&quiet, "quiet", false, "Disable request and validation logging.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if specPath == "" {
+		return fmt.Errorf("spec path is required (pass --spec)")
+	}
+
+	doc, err := loadSpec(specPath, *loadOptions)
+	if err != nil {
+		return err
+	}
+	options := mock.Options{}
+	if !quiet {
+		options.Logger = log.New(stdout, "", log.LstdFlags)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+	server := &http.Server{Handler: mock.New(doc, options), ReadHeaderTimeout: 10 * time.Second}
+	errs := make(chan error, 1)
+	go func() { errs <- server.Serve(listener) }()
+	if _, err := fmt.Fprintf(stdout, "Mock server listening on http://%s\n", listener.Addr()); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("serve: %w", err)
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutdown: %w", err)
+	}
+	return nil
+}
+
+func runValidateTraffic(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("codegen validate-traffic", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: codegen validate-traffic --spec <path> [flags] <file.har|file.jsonl|->")
+		flags.PrintDefaults()
+	}
+	var specPath, format string
+	var undocumented bool
+	loadOptions := specFlags(flags, &specPath)
+	flags.StringVar(&format, "format", "text", "Report format: text or json.")
+	flags.BoolVar(&undocumented, "undocumented", true, "Report object properties that the specification does not declare.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if specPath == "" {
+		return fmt.Errorf("spec path is required (pass --spec)")
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("exactly one traffic file is required (use - for stdin)")
+	}
+	if specPath == "-" && flags.Arg(0) == "-" {
+		return fmt.Errorf("--spec and the traffic file cannot both be read from stdin")
+	}
+	if format != "text" && format != "json" {
+		return fmt.Errorf("unsupported format %q (use text or json)", format)
+	}
+
+	doc, err := loadSpec(specPath, *loadOptions)
+	if err != nil {
+		return err
+	}
+	var input io.Reader = os.Stdin
+	if path := flags.Arg(0); path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("open traffic: %w", err)
+		}
+		defer file.Close()
+		input = file
+	}
+	exchanges, err := validate.ReadExchanges(input)
+	if err != nil {
+		return err
+	}
+
+	validator := validate.New(doc, validate.Options{Undocumented: undocumented})
+	results := make([]validate.Result, 0)
+	for index, exchange := range exchanges {
+		if result := validator.Exchange(index, exchange); len(result.Issues) > 0 {
+			results = append(results, result)
+		}
+	}
+
+	if format == "json" {
+		encoded, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encode report: %w", err)
+		}
+		if _, err := stdout.Write(append(encoded, '\n')); err != nil {
+			return err
+		}
+	} else {
+		for _, result := range results {
+			operation := result.Operation
+			if operation == "" {
+				operation = "undocumented"
+			}
+			if _, err := fmt.Fprintf(stdout, "#%d %s %s -> %d (%s)\n", result.Index, result.Method, result.Path, result.Status, operation); err != nil {
+				return err
+			}
+			for _, issue := range result.Issues {
+				if _, err := fmt.Fprintf(stdout, "  %s\n", issue); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(results) > 0 {
+		return fmt.Errorf("%d of %d exchanges do not match the specification", len(results), len(exchanges))
+	}
+	if format == "text" {
+		_, err = fmt.Fprintf(stdout, "All %d exchanges match the specification\n", len(exchanges))
+	}
+	return err
+}
+
+func runLint(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("codegen lint", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	var specPath, format, failOn, disable string
+	var listRules bool
+	loadOptions := specFlags(flags, &specPath)
+	flags.StringVar(&format, "format", "text", "Report format: text, json or sarif.")
+	flags.StringVar(&failOn, "fail-on", "error", "Lowest severity that fails the command: error, warning or info.")
+	flags.StringVar(&disable, "disable", "", "Comma-separated rule IDs to skip.")
+	flags.BoolVar(&listRules, "rules", false, "List the available rules and exit.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if listRules {
+		for _, rule := range lint.Rules() {
+			if _, err := fmt.Fprintf(stdout, "%s\t%s\t%s\n", rule.ID, rule.Severity, rule.Description); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if specPath == "" {
+		return fmt.Errorf("spec path is required (pass --spec)")
+	}
+	threshold, err := diag.ParseSeverity(failOn)
+	if err != nil {
+		return err
+	}
+
+	doc, err := loadSpec(specPath, *loadOptions)
+	if err != nil {
+		return err
+	}
+	var disabled []string
+	for _, id := range strings.Split(disable, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			disabled = append(disabled, id)
+		}
+	}
+	findings, err := lint.Run(doc, lint.Options{Disabled: disabled})
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "text":
+		err = lint.WriteText(stdout, findings)
+	case "json":
+		err = lint.WriteJSON(stdout, findings)
+	case "sarif":
+		err = lint.WriteSARIF(stdout, findings, filepath.ToSlash(specPath))
+	default:
+		return fmt.Errorf("unsupported format %q (use text, json or sarif)", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	failing := 0
+	for _, finding := range findings {
+		if finding.Severity >= threshold {
+			failing++
+		}
+	}
+	if failing > 0 {
+		return fmt.Errorf("lint: %d findings at or above %s", failing, threshold)
+	}
+	return nil
+}
+
+func runOverlay(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("codegen overlay", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	var specPath, output, format string
+	loadOptions := specFlags(flags, &specPath)
+	flags.StringVar(&output, "output", "", "Path to the merged specification (defaults to stdout).")
+	flags.StringVar(&format, "format", "json", "Output format: json or yaml.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if specPath == "" {
+		return fmt.Errorf("spec path is required (pass --spec)")
+	}
+	if format != "json" && format != "yaml" {
+		return fmt.Errorf("unsupported format %q (use json or yaml)", format)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	merged, err := spec.Read(ctx, specPath, *loadOptions)
 	if err != nil {
-		return nil, fmt.Errorf("resolve spec path: %w", err)
+		return fmt.Errorf("load spec: %w", err)
+	}
+	if format == "json" {
+		merged, err = spec.ToJSON(merged)
+	} else {
+		merged, err = spec.ToYAML(merged)
+	}
+	if err != nil {
+		return fmt.Errorf("encode merged spec: %w", err)
+	}
+	if output == "" {
+		_, err = stdout.Write(merged)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+		return fmt.Errorf("create output directory: %w", err)
+	}
+	if err := os.WriteFile(output, merged, 0o644); err != nil {
+		return fmt.Errorf("write merged spec: %w", err)
 	}
-	doc, err := spec.Load(context.Background(), absSpec)
+	return nil
+}
+
+func runDiff(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("codegen diff", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	var output, format string
+	var failOnBreaking bool
+	comparison := comparisonFlags(flags)
+	flags.StringVar(&output, "output", "", "Path to the report (defaults to stdout).")
+	flags.StringVar(&format, "format", "markdown", "Report format: markdown or json.")
+	flags.BoolVar(&failOnBreaking, "fail-on-breaking", false, "Exit with an error when breaking changes are found.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if format != "markdown" && format != "json" {
+		return fmt.Errorf("unsupported format %q (use markdown or json)", format)
+	}
+	changes, suggestion, err := comparison.compare()
+	if err != nil {
+		return err
+	}
+
+	var report bytes.Buffer
+	if format == "json" {
+		err = apidiff.WriteJSON(&report, changes, suggestion)
+	} else {
+		err = apidiff.WriteMarkdown(&report, changes, suggestion)
+	}
+	if err != nil {
+		return err
+	}
+	if err := writeOutput(stdout, output, report.Bytes()); err != nil {
+		return err
+	}
+
+	if failOnBreaking {
+		breaking := 0
+		for _, change := range changes {
+			if change.Breaking {
+				breaking++
+			}
+		}
+		if breaking > 0 {
+			return fmt.Errorf("diff: %d breaking changes", breaking)
+		}
+	}
+	return nil
+}
+
+func runChangelog(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("codegen changelog", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	var output, repository, date string
+	comparison := comparisonFlags(flags)
+	flags.StringVar(&output, "output", "", "Path to the changelog entries (defaults to stdout).")
+	flags.StringVar(&repository, "repository", "https://github.com/sumup/sumup-dotnet", "Repository URL linked from the release heading.")
+	flags.StringVar(&date, "date", time.Now().Format(time.DateOnly), "Release date shown in the heading.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	changes, suggestion, err := comparison.compare()
+	if err != nil {
+		return err
+	}
+
+	// Without a current version the entries are written without a heading,
+	// ready to paste under an existing release.
+	heading := ""
+	if suggestion.Next != "" {
+		heading = fmt.Sprintf("[%s](%s/compare/v%s...v%s) (%s)", suggestion.Next, strings.TrimSuffix(repository, "/"), strings.TrimPrefix(suggestion.Current, "v"), suggestion.Next, date)
+	}
+	var entries bytes.Buffer
+	if err := apidiff.WriteChangelog(&entries, changes, heading); err != nil {
+		return err
+	}
+	return writeOutput(stdout, output, entries.Bytes())
+}
+
+func runGraph(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("codegen graph", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	var configPath, specPath, output, format string
+	loadOptions := specFlags(flags, &specPath)
+	flags.StringVar(&configPath, "config", "", "Path to a codegen.yaml configuration; flags override its settings.")
+	flags.StringVar(&output, "output", "", "Path to the graph (defaults to stdout).")
+	flags.StringVar(&format, "format", "text", "Output format: text (unreferenced schemas), json or dot.")
+	filter := filterFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	cfg, err := loadConfig(flags, configPath, &specPath, loadOptions)
+	if err != nil {
+		return err
+	}
+	if specPath == "" {
+		return fmt.Errorf("spec path is required (pass --spec)")
+	}
+
+	doc, err := loadSpec(specPath, *loadOptions)
+	if err != nil {
+		return err
+	}
+	genConfig := cfg.Generator()
+	genConfig.OutputDir, genConfig.TestingOutputDir = "", ""
+	applyFilterFlags(flags, filter, &genConfig)
+	graph := generator.New(genConfig).ReferenceGraph(doc)
+
+	var report bytes.Buffer
+	switch format {
+	case "text":
+		unreferenced := graph.Unreferenced()
+		for _, name := range unreferenced {
+			fmt.Fprintf(&report, "%s: schema is not referenced by any operation\n", name)
+		}
+		fmt.Fprintf(&report, "%d of %d schemas unreferenced\n", len(unreferenced), len(graph.Schemas))
+	case "json":
+		encoded, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encode graph: %w", err)
+		}
+		report.Write(append(encoded, '\n'))
+	case "dot":
+		if err := graph.WriteDOT(&report); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported format %q (use text, json or dot)", format)
+	}
+	return writeOutput(stdout, output, report.Bytes())
+}
+
+func runTemplates(args []string, stdout io.Writer) error {
+	if len(args) == 0 || args[0] != "export" {
+		return fmt.Errorf("usage: codegen templates export [--output dir] [--force]")
+	}
+	flags := flag.NewFlagSet("codegen templates export", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	var output string
+	var force bool
+	flags.StringVar(&output, "output", "templates", "Directory where the embedded templates are written.")
+	flags.BoolVar(&force, "force", false, "Overwrite existing files.")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected argument %q (pass the directory with --output)", flags.Arg(0))
+	}
+
+	paths, err := generator.ExportTemplates(output, force)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if _, err := fmt.Fprintln(stdout, path); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(stdout, "Exported %d templates for template data version %d\n", len(paths)-1, generator.TemplateDataVersion)
+	return err
+}
+
+// comparison holds the flags shared by the commands comparing two
+// specifications.
+type comparison struct {
+	flags                                  *flag.FlagSet
+	configPath, oldPath, newPath           string
+	namespace                              string
+	sdkVersion, sdkVersionFile, releaseCfg string
+	loadOptions                            *spec.LoadOptions
+}
+
+func comparisonFlags(flags *flag.FlagSet) *comparison {
+	c := &comparison{flags: flags, loadOptions: loadFlags(flags)}
+	flags.StringVar(&c.configPath, "config", "", "Path to a codegen.yaml configuration; its spec is the default of --new and flags override its settings.")
+	flags.StringVar(&c.oldPath, "old", "", "Path or http(s) URL of the previous OpenAPI specification.")
+	flags.StringVar(&c.newPath, "new", "", "Path or http(s) URL of the updated OpenAPI specification.")
+	flags.StringVar(&c.namespace, "namespace", "SumUp", "Root namespace of the generated SDK.")
+	flags.StringVar(&c.sdkVersion, "sdk-version", "", "Current SDK version, used to suggest the next one.")
+	flags.StringVar(&c.sdkVersionFile, "sdk-version-file", "", "MSBuild project containing the current SDK Version property.")
+	flags.StringVar(&c.releaseCfg, "release-config", "", "release-please-config.json whose pre-1.0 bump settings apply.")
+	return c
+}
+
+// compare builds the SDK surface of both specifications with the configured
+// generator settings and returns their differences with the version bump they
+// call for.
+func (c *comparison) compare() ([]apidiff.Change, apidiff.Suggestion, error) {
+	cfg := &config.Config{}
+	if c.configPath != "" {
+		var err error
+		if cfg, err = config.Load(c.configPath); err != nil {
+			return nil, apidiff.Suggestion{}, err
+		}
+	}
+	configure(c.flags, "new", &c.newPath, cfg.Spec)
+	if !flagSet(c.flags, "overlay") {
+		c.loadOptions.Overlays = cfg.Overlays
+	}
+	configure(c.flags, "namespace", &c.namespace, cfg.Namespace)
+	configure(c.flags, "release-config", &c.releaseCfg, cfg.ReleaseConfig)
+	if !flagSet(c.flags, "sdk-version") && !flagSet(c.flags, "sdk-version-file") && (cfg.Samples.SDKVersion != "" || cfg.Samples.SDKVersionFile != "") {
+		c.sdkVersion, c.sdkVersionFile = cfg.Samples.SDKVersion, cfg.Samples.SDKVersionFile
+	}
+	if c.oldPath == "" || c.newPath == "" {
+		return nil, apidiff.Suggestion{}, fmt.Errorf("both specifications are required (pass --old and --new)")
+	}
+	if c.oldPath == "-" && c.newPath == "-" {
+		return nil, apidiff.Suggestion{}, fmt.Errorf("--old and --new cannot both read stdin")
+	}
+	sdkVersion := c.sdkVersion
+	if sdkVersion == "" && c.sdkVersionFile != "" {
+		version, err := readSDKVersion(c.sdkVersionFile)
+		if err != nil {
+			return nil, apidiff.Suggestion{}, err
+		}
+		sdkVersion = version
+	}
+	var policy apidiff.Policy
+	if c.releaseCfg != "" {
+		var err error
+		if policy, err = apidiff.LoadPolicy(c.releaseCfg); err != nil {
+			return nil, apidiff.Suggestion{}, err
+		}
+	}
+	genConfig := cfg.Generator()
+	genConfig.OutputDir, genConfig.TestingOutputDir, genConfig.Namespace = "", "", c.namespace
+
+	surfaces := make([]*generator.Surface, 0, 2)
+	for _, location := range []string{c.oldPath, c.newPath} {
+		doc, err := loadSpec(location, *c.loadOptions)
+		if err != nil {
+			return nil, apidiff.Suggestion{}, fmt.Errorf("%s: %w", location, err)
+		}
+		surface, err := generator.New(genConfig).Surface(doc)
+		if err != nil {
+			return nil, apidiff.Suggestion{}, fmt.Errorf("%s: build SDK surface: %w", location, err)
+		}
+		surfaces = append(surfaces, surface)
+	}
+	changes := apidiff.Compare(surfaces[0], surfaces[1])
+	suggestion, err := apidiff.Suggest(changes, policy, sdkVersion)
+	if err != nil {
+		return nil, apidiff.Suggestion{}, err
+	}
+	return changes, suggestion, nil
+}
+
+// writeOutput writes data to path, or to stdout when path is empty.
+func writeOutput(stdout io.Writer, path string, data []byte) error {
+	if path == "" {
+		_, err := stdout.Write(data)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create output directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
+
+// specFlags registers --spec and the flags controlling how it is loaded.
+func specFlags(flags *flag.FlagSet, specPath *string) *spec.LoadOptions {
+	flags.StringVar(specPath, "spec", "", "Path or http(s) URL of the OpenAPI specification (JSON or YAML), or - for stdin.")
+	return loadFlags(flags)
+}
+
+// loadFlags registers the flags controlling how specifications are loaded.
+func loadFlags(flags *flag.FlagSet) *spec.LoadOptions {
+	opts := &spec.LoadOptions{}
+	cacheDir := ""
+	if userCache, err := os.UserCacheDir(); err == nil {
+		cacheDir = filepath.Join(userCache, "sumup-dotnet-codegen", "specs")
+	}
+	flags.StringVar(&opts.CacheDir, "spec-cache", cacheDir, "Directory caching remote specifications by ETag (empty disables caching).")
+	flags.BoolVar(&opts.Offline, "offline", false, "Load remote specifications from the cache only.")
+	flags.Func("overlay", "OpenAPI Overlay document applied to the specification before loading (repeatable).", func(value string) error {
+		opts.Overlays = append(opts.Overlays, value)
+		return nil
+	})
+	opts.Logger = log.Default()
+	return opts
+}
+
+func loadSpec(location string, opts spec.LoadOptions) (*v3.Document, error) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	doc, err := spec.Load(ctx, location, opts)
 	if err != nil {
 		return nil, fmt.Errorf("load spec: %w", err)
 	}
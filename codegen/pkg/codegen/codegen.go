@@ -0,0 +1,79 @@
+// Package codegen builds the intermediate representation of the SumUp .NET
+// SDK, a projection of the model the generator renders to C# and code
+// samples, for tools that describe the SDK without generating it.
+//
+//	doc, err := codegen.Load(ctx, "openapi.json")
+//	...
+//	api, err := codegen.Build(doc, codegen.Options{ConfigFile: "codegen/codegen.yaml"})
+package codegen
+
+import (
+	"context"
+	"fmt"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sumup/sumup-dotnet/codegen/internal/config"
+	"github.com/sumup/sumup-dotnet/codegen/internal/generator"
+	"github.com/sumup/sumup-dotnet/codegen/internal/spec"
+	"github.com/sumup/sumup-dotnet/codegen/pkg/ir"
+)
+
+// Options configures Build.
+type Options struct {
+	// ConfigFile is a codegen.yaml whose names, type mappings and filters
+	// apply, so the representation matches the SDK generated with it.
+	ConfigFile string
+	// Namespace overrides the root C# namespace (defaults to "SumUp").
+	Namespace string
+	// Include and Exclude, when set, replace the filters of ConfigFile.
+	Include Selector
+	Exclude Selector
+	// PruneUnreferenced skips component schemas no operation references.
+	PruneUnreferenced bool
+}
+
+// Selector matches operations by tag, operationId glob or path prefix. An
+// operation matches when any criterion does.
+type Selector struct {
+	Tags       []string
+	Operations []string
+	Paths      []string
+}
+
+// Load returns the OpenAPI document at location, a file path or http(s) URL,
+// with the OpenAPI Overlay documents at overlays applied in order.
+func Load(ctx context.Context, location string, overlays ...string) (*v3.Document, error) {
+	doc, err := spec.Load(ctx, location, spec.LoadOptions{Overlays: overlays})
+	if err != nil {
+		return nil, fmt.Errorf("load spec: %w", err)
+	}
+	return doc, nil
+}
+
+// Build returns the intermediate representation of the SDK generated for
+// doc. Spec constructs the generator cannot handle fully are reported in
+// API.Diagnostics; constructs it cannot handle at all fail the build.
+func Build(doc *v3.Document, opts Options) (*ir.API, error) {
+	var genConfig generator.Config
+	if opts.ConfigFile != "" {
+		cfg, err := config.Load(opts.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		genConfig = cfg.Generator()
+	}
+	if opts.Namespace != "" {
+		genConfig.Namespace = opts.Namespace
+	}
+	filter := generator.Filter{
+		Include: generator.Selector(opts.Include),
+		Exclude: generator.Selector(opts.Exclude),
+	}
+	if filter.Include.Tags != nil || filter.Include.Operations != nil || filter.Include.Paths != nil ||
+		filter.Exclude.Tags != nil || filter.Exclude.Operations != nil || filter.Exclude.Paths != nil {
+		genConfig.Filter = filter
+	}
+	genConfig.PruneUnreferenced = genConfig.PruneUnreferenced || opts.PruneUnreferenced
+	return generator.New(genConfig).IR(doc)
+}
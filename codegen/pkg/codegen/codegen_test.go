@@ -0,0 +1,89 @@
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSpec = `openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+paths:
+  /v0.1/checkouts:
+    get:
+      tags: [Checkouts]
+      operationId: ListCheckouts
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Checkout"
+  /v0.1/readers:
+    get:
+      tags: [Readers]
+      operationId: ListReaders
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Reader"
+components:
+  schemas:
+    Checkout:
+      type: object
+      properties:
+        id: { type: string }
+    Reader:
+      type: object
+      properties:
+        id: { type: string }
+`
+
+func TestBuild(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "openapi.yaml"), testSpec)
+	writeFile(t, filepath.Join(dir, "codegen.yaml"), `version: 1
+namespace: Acme.Payments
+names:
+  clients:
+    Checkouts: Payments
+  models:
+    Checkout: Payment
+`)
+
+	doc, err := Load(t.Context(), filepath.Join(dir, "openapi.yaml"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	api, err := Build(doc, Options{ConfigFile: filepath.Join(dir, "codegen.yaml"), Include: Selector{Tags: []string{"Checkouts"}}})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	if api.Namespace != "Acme.Payments" {
+		t.Errorf("Namespace = %q, want the configured namespace", api.Namespace)
+	}
+	if len(api.Clients) != 1 || api.Clients[0].Name != "Payments" || api.Clients[0].Operations[0].Response.Type != "Payment" {
+		t.Fatalf("Clients = %+v, want the renamed Checkouts client", api.Clients)
+	}
+	if len(api.Models) != 1 || api.Models[0].Name != "Payment" || api.Models[0].Pointer != "#/components/schemas/Checkout" {
+		t.Fatalf("Models = %+v, want the renamed Checkout model only", api.Models)
+	}
+
+	if _, err := Build(doc, Options{Exclude: Selector{Paths: []string{"/"}}}); err == nil {
+		t.Fatal("Build() succeeded although the filter excludes every operation")
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
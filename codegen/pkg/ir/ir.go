@@ -0,0 +1,230 @@
+// Package ir is the intermediate representation of the SumUp .NET SDK built
+// from an OpenAPI document: the clients, operations, models and options
+// classes the generator renders, with the spec locations they come from.
+//
+// The representation is independent of how it is rendered. Names and types
+// are those of the generated C#, so tools describing the SDK (documentation,
+// linting, portals) agree with it. Use package codegen to build it.
+//
+// Fields are only added in minor releases; removing or changing the meaning
+// of one bumps Version.
+package ir
+
+// Version is the version of the representation, recorded in API.Version.
+const Version = 1
+
+// API is the SDK generated for one OpenAPI document.
+type API struct {
+	Version int `json:"version"`
+	// Title and APIVersion are the info.title and info.version of the spec.
+	Title      string `json:"title"`
+	APIVersion string `json:"apiVersion"`
+	// Namespace is the root C# namespace.
+	Namespace string `json:"namespace"`
+	// Clients, Models and Options are sorted by name.
+	Clients     []Client     `json:"clients"`
+	Models      []Model      `json:"models"`
+	Options     []Options    `json:"options"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// Client groups the operations of a tag.
+type Client struct {
+	// Name is the client name without the "Client" suffix, e.g. "Checkouts".
+	Name string `json:"name"`
+	// Property is the SumUpClient property exposing the client.
+	Property string `json:"property"`
+	// Tag is the tag the operations share, "Core" for untagged operations.
+	Tag         string `json:"tag"`
+	Description string `json:"description,omitempty"`
+	// Operations are sorted by method name.
+	Operations []Operation `json:"operations"`
+}
+
+// Operation is a client method.
+type Operation struct {
+	// ID is the operationId; empty when the spec has none.
+	ID string `json:"id,omitempty"`
+	// Method is the C# method name without the Async suffix.
+	Method string `json:"method"`
+	// HTTPMethod is the uppercase HTTP method, e.g. "POST".
+	HTTPMethod  string `json:"httpMethod"`
+	Path        string `json:"path"`
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Pointer is the JSON pointer of the operation in the spec.
+	Pointer string `json:"pointer"`
+	// Parameters are the path, query and header parameters, in that order.
+	Parameters  []Parameter `json:"parameters"`
+	RequestBody *Body       `json:"requestBody,omitempty"`
+	Response    Response    `json:"response"`
+	// Errors map documented error statuses to typed exceptions.
+	Errors []ErrorResponse `json:"errors,omitempty"`
+	// Options names the options class holding the query and header
+	// parameters, if any.
+	Options    string `json:"options,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
+
+// Parameter is a path, query or header parameter.
+type Parameter struct {
+	// Name is the name in the spec.
+	Name string `json:"name"`
+	// In is "path", "query" or "header".
+	In string `json:"in"`
+	// Argument is the C# method parameter name of path parameters, and
+	// Property the options property of query and header parameters.
+	Argument    string `json:"argument,omitempty"`
+	Property    string `json:"property,omitempty"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	// BuilderCall is the C# statement adding the parameter to the request,
+	// e.g. builder.AddPath("merchant_code", merchantCode);.
+	BuilderCall string `json:"builderCall"`
+	// Default is the schema default as written in the spec. Requests omit
+	// values equal to it when SumUpClientOptions.OmitDefaultParameters is
+	// turned on.
+	Default string `json:"default,omitempty"`
+	// Example is the first documented scalar example.
+	Example string `json:"example,omitempty"`
+	Pointer string `json:"pointer"`
+}
+
+// Body is a request body.
+type Body struct {
+	Argument    string `json:"argument"`
+	ContentType string `json:"contentType"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// Response is the successful response of an operation.
+type Response struct {
+	// Type is the C# type of the response body; empty when there is none.
+	Type string `json:"type,omitempty"`
+	// Mode is how the body is read: "json", "json-document", "string" or
+	// "none".
+	Mode string `json:"mode"`
+}
+
+// ErrorResponse is a documented error response.
+type ErrorResponse struct {
+	// Status is the status code, or "default" for any other error status.
+	Status string `json:"status"`
+	Type   string `json:"type"`
+}
+
+// ModelKind distinguishes the kinds of generated models.
+type ModelKind string
+
+const (
+	// ModelClass is a class with properties.
+	ModelClass ModelKind = "class"
+	// ModelDictionary is a class deriving from a dictionary.
+	ModelDictionary ModelKind = "dictionary"
+	// ModelEnum is an enum.
+	ModelEnum ModelKind = "enum"
+	// ModelPatch is a builder of JSON Merge Patch or JSON Patch documents
+	// between two copies of a model.
+	ModelPatch ModelKind = "patch"
+)
+
+// Model is a generated model: a component schema, a 3.1 $defs entry or an
+// inline schema promoted to a named type.
+type Model struct {
+	Name        string    `json:"name"`
+	Kind        ModelKind `json:"kind"`
+	Description string    `json:"description,omitempty"`
+	// Pointer is the JSON pointer of the schema in the spec.
+	Pointer string `json:"pointer"`
+	// Base is the class a class derives from, whose properties and
+	// additional properties it inherits.
+	Base string `json:"base,omitempty"`
+	// Properties of classes, including inherited ones, are sorted by JSON
+	// name.
+	Properties []Property `json:"properties,omitempty"`
+	// AdditionalProperties is the value type of undeclared properties of
+	// classes and of the entries of dictionaries; empty when not allowed.
+	AdditionalProperties string `json:"additionalProperties,omitempty"`
+	// Record reports whether a class is a record with init accessors, whose
+	// required properties are required members.
+	Record bool `json:"record,omitempty"`
+	// InputOf is the model a request input variant is derived from, which
+	// leaves out the readOnly properties of the model, or the model a shared
+	// partial update is derived from.
+	InputOf string `json:"inputOf,omitempty"`
+	// Values are the members of enums.
+	Values []EnumValue `json:"values,omitempty"`
+	// Resource is the model patch builders diff copies of, and Format
+	// "merge" for JSON Merge Patch or "json" for JSON Patch.
+	Resource   string `json:"resource,omitempty"`
+	Format     string `json:"format,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
+
+// Property is a property of a class model.
+type Property struct {
+	// Name is the C# property name and JSONName the serialized one.
+	Name        string `json:"name"`
+	JSONName    string `json:"jsonName"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	// Nullable reports whether Type accepts null, which optional properties
+	// and nullable schemas do.
+	Nullable bool `json:"nullable,omitempty"`
+	// Optional reports whether Type is Optional<T>, which partial updates use
+	// to tell an omitted value from an explicit null.
+	Optional   bool `json:"optional,omitempty"`
+	ReadOnly   bool `json:"readOnly,omitempty"`
+	Deprecated bool `json:"deprecated,omitempty"`
+	// Inherited reports whether a base class declares the property.
+	Inherited bool `json:"inherited,omitempty"`
+	// Default is the schema default as written in the spec, which the
+	// property is initialized to when its type has a literal for it and the
+	// model is not sent in a request body.
+	Default string `json:"default,omitempty"`
+	Pointer string `json:"pointer"`
+}
+
+// EnumValue is a member of an enum model.
+type EnumValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Options is the class holding the query and header parameters of an
+// operation.
+type Options struct {
+	Name string `json:"name"`
+	// Operation is the pointer of the operation the options belong to.
+	Operation  string            `json:"operation"`
+	Properties []OptionsProperty `json:"properties"`
+	// Required reports whether a property is required, which makes the
+	// options argument required.
+	Required bool `json:"required,omitempty"`
+}
+
+// OptionsProperty is a property of an options class.
+type OptionsProperty struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Default     string `json:"default,omitempty"`
+}
+
+// Diagnostic describes a spec construct the generator could not handle fully.
+type Diagnostic struct {
+	Code string `json:"code"`
+	// Severity is "error", "warning" or "info".
+	Severity string `json:"severity"`
+	Pointer  string `json:"pointer"`
+	// Line and Column locate the pointer in the spec source; zero when
+	// unknown.
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
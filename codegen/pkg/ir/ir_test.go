@@ -0,0 +1,34 @@
+package ir
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDocumentEveryField(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("..", "..", "IR.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := string(content)
+	if !strings.Contains(doc, "version **"+strconv.Itoa(Version)+"**") {
+		t.Errorf("IR.md does not describe version %d", Version)
+	}
+
+	for _, value := range []any{
+		API{}, Client{}, Operation{}, Parameter{}, Body{}, Response{}, ErrorResponse{},
+		Model{}, Property{}, EnumValue{}, Options{}, OptionsProperty{}, Diagnostic{},
+	} {
+		typ := reflect.TypeOf(value)
+		for i := 0; i < typ.NumField(); i++ {
+			name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+			if !strings.Contains(doc, "`"+name+"`") {
+				t.Errorf("IR.md does not document %s.%s as %q", typ.Name(), typ.Field(i).Name, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Checkouts API endpoints.
+/// </summary>
+public sealed partial class FakeCheckoutsRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeCheckoutsRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CheckoutsClient.Create</c>.
+    /// </summary>
+    /// <remarks>Create a checkout</remarks>
+    public FakeRoute<Checkout> OnCreate()
+    {
+        return _handler.Register<Checkout>(
+            HttpMethod.Post,
+            "/v0.1/checkouts",
+            "application/json",
+            FakeSumUpHandler.NoPathParameters);
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CheckoutsClient.CreateApplePaySession</c>.
+    /// </summary>
+    /// <remarks>Create an Apple Pay session</remarks>
+    /// <param name="checkoutId">Unique identifier of the checkout resource. Matches any value when null.</param>
+    public FakeRoute<JsonDocument> OnCreateApplePaySession(string? checkoutId = null)
+    {
+        return _handler.Register<JsonDocument>(
+            HttpMethod.Put,
+            "/v0.2/checkouts/{checkout_id}/apple-pay-session",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["checkout_id"] = checkoutId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CheckoutsClient.Deactivate</c>.
+    /// </summary>
+    /// <remarks>Deactivate a checkout</remarks>
+    /// <param name="checkoutId">Unique identifier of the checkout resource. Matches any value when null.</param>
+    public FakeRoute<Checkout> OnDeactivate(string? checkoutId = null)
+    {
+        return _handler.Register<Checkout>(
+            HttpMethod.Delete,
+            "/v0.1/checkouts/{checkout_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["checkout_id"] = checkoutId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CheckoutsClient.Get</c>.
+    /// </summary>
+    /// <remarks>Retrieve a checkout</remarks>
+    /// <param name="checkoutId">Unique identifier of the checkout resource. Matches any value when null.</param>
+    public FakeRoute<CheckoutSuccess> OnGet(string? checkoutId = null)
+    {
+        return _handler.Register<CheckoutSuccess>(
+            HttpMethod.Get,
+            "/v0.1/checkouts/{checkout_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["checkout_id"] = checkoutId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CheckoutsClient.List</c>.
+    /// </summary>
+    /// <remarks>List checkouts</remarks>
+    public FakeRoute<IEnumerable<CheckoutSuccess>> OnList()
+    {
+        return _handler.Register<IEnumerable<CheckoutSuccess>>(
+            HttpMethod.Get,
+            "/v0.1/checkouts",
+            "application/json",
+            FakeSumUpHandler.NoPathParameters);
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CheckoutsClient.ListAvailablePaymentMethods</c>.
+    /// </summary>
+    /// <remarks>Get available payment methods</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<CheckoutsListAvailablePaymentMethodsResponse> OnListAvailablePaymentMethods(string? merchantCode = null)
+    {
+        return _handler.Register<CheckoutsListAvailablePaymentMethodsResponse>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/payment-methods",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CheckoutsClient.Update</c>.
+    /// </summary>
+    /// <remarks>Update a checkout</remarks>
+    /// <param name="checkoutId">Unique identifier of the checkout resource. Matches any value when null.</param>
+    public FakeRoute<Checkout> OnUpdate(string? checkoutId = null)
+    {
+        return _handler.Register<Checkout>(
+            new HttpMethod("PATCH"),
+            "/v0.1/checkouts/{checkout_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["checkout_id"] = checkoutId,
+            });
+    }
+}
\ No newline at end of file
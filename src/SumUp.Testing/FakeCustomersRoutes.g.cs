@@ -0,0 +1,104 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Customers API endpoints.
+/// </summary>
+public sealed partial class FakeCustomersRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeCustomersRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CustomersClient.Create</c>.
+    /// </summary>
+    /// <remarks>Create a customer</remarks>
+    public FakeRoute<Customer> OnCreate()
+    {
+        return _handler.Register<Customer>(
+            HttpMethod.Post,
+            "/v0.1/customers",
+            "application/json",
+            FakeSumUpHandler.NoPathParameters);
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CustomersClient.DeactivatePaymentInstrument</c>.
+    /// </summary>
+    /// <remarks>Deactivate a payment instrument</remarks>
+    /// <param name="customerId">Unique identifier of the saved customer resource. Matches any value when null.</param>
+    /// <param name="token">Unique token identifying the card saved as a payment instrument resource. Matches any value when null.</param>
+    public FakeRoute<JsonDocument> OnDeactivatePaymentInstrument(string? customerId = null, string? token = null)
+    {
+        return _handler.Register<JsonDocument>(
+            HttpMethod.Delete,
+            "/v0.1/customers/{customer_id}/payment-instruments/{token}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["customer_id"] = customerId,
+                ["token"] = token,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CustomersClient.Get</c>.
+    /// </summary>
+    /// <remarks>Retrieve a customer</remarks>
+    /// <param name="customerId">Unique identifier of the saved customer resource. Matches any value when null.</param>
+    public FakeRoute<Customer> OnGet(string? customerId = null)
+    {
+        return _handler.Register<Customer>(
+            HttpMethod.Get,
+            "/v0.1/customers/{customer_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["customer_id"] = customerId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CustomersClient.ListPaymentInstruments</c>.
+    /// </summary>
+    /// <remarks>List payment instruments</remarks>
+    /// <param name="customerId">Unique identifier of the saved customer resource. Matches any value when null.</param>
+    public FakeRoute<IEnumerable<PaymentInstrumentResponse>> OnListPaymentInstruments(string? customerId = null)
+    {
+        return _handler.Register<IEnumerable<PaymentInstrumentResponse>>(
+            HttpMethod.Get,
+            "/v0.1/customers/{customer_id}/payment-instruments",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["customer_id"] = customerId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>CustomersClient.Update</c>.
+    /// </summary>
+    /// <remarks>Update a customer</remarks>
+    /// <param name="customerId">Unique identifier of the saved customer resource. Matches any value when null.</param>
+    public FakeRoute<Customer> OnUpdate(string? customerId = null)
+    {
+        return _handler.Register<Customer>(
+            HttpMethod.Put,
+            "/v0.1/customers/{customer_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["customer_id"] = customerId,
+            });
+    }
+}
\ No newline at end of file
@@ -0,0 +1,112 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Members API endpoints.
+/// </summary>
+public sealed partial class FakeMembersRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeMembersRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MembersClient.Create</c>.
+    /// </summary>
+    /// <remarks>Create a member</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<Member> OnCreate(string? merchantCode = null)
+    {
+        return _handler.Register<Member>(
+            HttpMethod.Post,
+            "/v0.1/merchants/{merchant_code}/members",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MembersClient.Delete</c>.
+    /// </summary>
+    /// <remarks>Delete a member</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="memberId">The ID of the member to retrieve. Matches any value when null.</param>
+    public FakeRoute<JsonDocument> OnDelete(string? merchantCode = null, string? memberId = null)
+    {
+        return _handler.Register<JsonDocument>(
+            HttpMethod.Delete,
+            "/v0.1/merchants/{merchant_code}/members/{member_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["member_id"] = memberId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MembersClient.Get</c>.
+    /// </summary>
+    /// <remarks>Retrieve a member</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="memberId">The ID of the member to retrieve. Matches any value when null.</param>
+    public FakeRoute<Member> OnGet(string? merchantCode = null, string? memberId = null)
+    {
+        return _handler.Register<Member>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/members/{member_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["member_id"] = memberId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MembersClient.List</c>.
+    /// </summary>
+    /// <remarks>List members</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<MembersListResponse> OnList(string? merchantCode = null)
+    {
+        return _handler.Register<MembersListResponse>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/members",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MembersClient.Update</c>.
+    /// </summary>
+    /// <remarks>Update a member</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="memberId">The ID of the member to retrieve. Matches any value when null.</param>
+    public FakeRoute<Member> OnUpdate(string? merchantCode = null, string? memberId = null)
+    {
+        return _handler.Register<Member>(
+            HttpMethod.Put,
+            "/v0.1/merchants/{merchant_code}/members/{member_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["member_id"] = memberId,
+            });
+    }
+}
\ No newline at end of file
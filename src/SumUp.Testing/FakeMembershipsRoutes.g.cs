@@ -0,0 +1,34 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Memberships API endpoints.
+/// </summary>
+public sealed partial class FakeMembershipsRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeMembershipsRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MembershipsClient.List</c>.
+    /// </summary>
+    /// <remarks>List memberships</remarks>
+    public FakeRoute<MembershipsListResponse> OnList()
+    {
+        return _handler.Register<MembershipsListResponse>(
+            HttpMethod.Get,
+            "/v0.1/memberships",
+            "application/json",
+            FakeSumUpHandler.NoPathParameters);
+    }
+}
\ No newline at end of file
@@ -0,0 +1,74 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Merchants API endpoints.
+/// </summary>
+public sealed partial class FakeMerchantsRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeMerchantsRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MerchantsClient.Get</c>.
+    /// </summary>
+    /// <remarks>Get Merchant</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<Merchant> OnGet(string? merchantCode = null)
+    {
+        return _handler.Register<Merchant>(
+            HttpMethod.Get,
+            "/v1/merchants/{merchant_code}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MerchantsClient.GetPerson</c>.
+    /// </summary>
+    /// <remarks>Get Person</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="personId">Person ID. Matches any value when null.</param>
+    public FakeRoute<Person> OnGetPerson(string? merchantCode = null, string? personId = null)
+    {
+        return _handler.Register<Person>(
+            HttpMethod.Get,
+            "/v1/merchants/{merchant_code}/persons/{person_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["person_id"] = personId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>MerchantsClient.ListPersons</c>.
+    /// </summary>
+    /// <remarks>List Persons</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<ListPersonsResponseBody> OnListPersons(string? merchantCode = null)
+    {
+        return _handler.Register<ListPersonsResponseBody>(
+            HttpMethod.Get,
+            "/v1/merchants/{merchant_code}/persons",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+}
\ No newline at end of file
@@ -0,0 +1,38 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Payouts API endpoints.
+/// </summary>
+public sealed partial class FakePayoutsRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakePayoutsRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>PayoutsClient.List</c>.
+    /// </summary>
+    /// <remarks>List payouts</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<IEnumerable<FinancialPayout>> OnList(string? merchantCode = null)
+    {
+        return _handler.Register<IEnumerable<FinancialPayout>>(
+            HttpMethod.Get,
+            "/v1.0/merchants/{merchant_code}/payouts",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+}
\ No newline at end of file
@@ -0,0 +1,209 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Readers API endpoints.
+/// </summary>
+public sealed partial class FakeReadersRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeReadersRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.Create</c>.
+    /// </summary>
+    /// <remarks>Create a Reader</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<Reader> OnCreate(string? merchantCode = null)
+    {
+        return _handler.Register<Reader>(
+            HttpMethod.Post,
+            "/v0.1/merchants/{merchant_code}/readers",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.CreateCheckout</c>.
+    /// </summary>
+    /// <remarks>Create a Reader Checkout</remarks>
+    /// <param name="merchantCode">Merchant Code. Matches any value when null.</param>
+    /// <param name="readerId">The unique identifier of the Reader. Matches any value when null.</param>
+    public FakeRoute<CreateReaderCheckoutResponse> OnCreateCheckout(string? merchantCode = null, string? readerId = null)
+    {
+        return _handler.Register<CreateReaderCheckoutResponse>(
+            HttpMethod.Post,
+            "/v0.1/merchants/{merchant_code}/readers/{reader_id}/checkout",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["reader_id"] = readerId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.CreateGoCheckout</c>.
+    /// </summary>
+    /// <remarks>Create a Go Reader Payment</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="readerId">The unique identifier of the reader. Matches any value when null.</param>
+    public FakeRoute<ReaderPaymentResponse> OnCreateGoCheckout(string? merchantCode = null, string? readerId = null)
+    {
+        return _handler.Register<ReaderPaymentResponse>(
+            HttpMethod.Post,
+            "/v0/merchants/{merchant_code}/readers/{reader_id}/go-checkout",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["reader_id"] = readerId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.Delete</c>.
+    /// </summary>
+    /// <remarks>Delete a reader</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="readerId">The unique identifier of the reader. Matches any value when null.</param>
+    public FakeRoute<JsonDocument> OnDelete(string? merchantCode = null, string? readerId = null)
+    {
+        return _handler.Register<JsonDocument>(
+            HttpMethod.Delete,
+            "/v0.1/merchants/{merchant_code}/readers/{reader_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["reader_id"] = readerId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.Get</c>.
+    /// </summary>
+    /// <remarks>Retrieve a Reader</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="readerId">The unique identifier of the reader. Matches any value when null.</param>
+    public FakeRoute<Reader> OnGet(string? merchantCode = null, string? readerId = null)
+    {
+        return _handler.Register<Reader>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/readers/{reader_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["reader_id"] = readerId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.GetCheckout</c>.
+    /// </summary>
+    /// <remarks>Get a Reader Checkout</remarks>
+    /// <param name="merchantCode">Merchant Code. Matches any value when null.</param>
+    /// <param name="readerId">The unique identifier of the Reader. Matches any value when null.</param>
+    /// <param name="checkoutId">The unique identifier of the Checkout. Matches any value when null.</param>
+    public FakeRoute<GetReaderCheckoutResponse> OnGetCheckout(string? merchantCode = null, string? readerId = null, string? checkoutId = null)
+    {
+        return _handler.Register<GetReaderCheckoutResponse>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/readers/{reader_id}/checkout/{checkout_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["reader_id"] = readerId,
+                ["checkout_id"] = checkoutId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.GetStatus</c>.
+    /// </summary>
+    /// <remarks>Get a Reader Status</remarks>
+    /// <param name="merchantCode">Merchant Code. Matches any value when null.</param>
+    /// <param name="readerId">The unique identifier of the Reader. Matches any value when null.</param>
+    public FakeRoute<StatusResponse> OnGetStatus(string? merchantCode = null, string? readerId = null)
+    {
+        return _handler.Register<StatusResponse>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/readers/{reader_id}/status",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["reader_id"] = readerId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.List</c>.
+    /// </summary>
+    /// <remarks>List Readers</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<ReadersListResponse> OnList(string? merchantCode = null)
+    {
+        return _handler.Register<ReadersListResponse>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/readers",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.TerminateCheckout</c>.
+    /// </summary>
+    /// <remarks>Terminate a Reader Checkout</remarks>
+    /// <param name="merchantCode">Merchant Code. Matches any value when null.</param>
+    /// <param name="readerId">The unique identifier of the Reader. Matches any value when null.</param>
+    public FakeRoute<JsonDocument> OnTerminateCheckout(string? merchantCode = null, string? readerId = null)
+    {
+        return _handler.Register<JsonDocument>(
+            HttpMethod.Post,
+            "/v0.1/merchants/{merchant_code}/readers/{reader_id}/terminate",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["reader_id"] = readerId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReadersClient.Update</c>.
+    /// </summary>
+    /// <remarks>Update a Reader</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="readerId">The unique identifier of the reader. Matches any value when null.</param>
+    public FakeRoute<Reader> OnUpdate(string? merchantCode = null, string? readerId = null)
+    {
+        return _handler.Register<Reader>(
+            new HttpMethod("PATCH"),
+            "/v0.1/merchants/{merchant_code}/readers/{reader_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["reader_id"] = readerId,
+            });
+    }
+}
\ No newline at end of file
@@ -0,0 +1,38 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Receipts API endpoints.
+/// </summary>
+public sealed partial class FakeReceiptsRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeReceiptsRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>ReceiptsClient.Get</c>.
+    /// </summary>
+    /// <remarks>Retrieve receipt details</remarks>
+    /// <param name="transactionId">SumUp unique transaction ID or transaction code, e.g. TS7HDYLSKD. Matches any value when null.</param>
+    public FakeRoute<Receipt> OnGet(string? transactionId = null)
+    {
+        return _handler.Register<Receipt>(
+            HttpMethod.Get,
+            "/v1.1/receipts/{transaction_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["transaction_id"] = transactionId,
+            });
+    }
+}
\ No newline at end of file
@@ -0,0 +1,112 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Roles API endpoints.
+/// </summary>
+public sealed partial class FakeRolesRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeRolesRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>RolesClient.Create</c>.
+    /// </summary>
+    /// <remarks>Create a role</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<Role> OnCreate(string? merchantCode = null)
+    {
+        return _handler.Register<Role>(
+            HttpMethod.Post,
+            "/v0.1/merchants/{merchant_code}/roles",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>RolesClient.Delete</c>.
+    /// </summary>
+    /// <remarks>Delete a role</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="roleId">The ID of the role to retrieve. Matches any value when null.</param>
+    public FakeRoute<JsonDocument> OnDelete(string? merchantCode = null, string? roleId = null)
+    {
+        return _handler.Register<JsonDocument>(
+            HttpMethod.Delete,
+            "/v0.1/merchants/{merchant_code}/roles/{role_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["role_id"] = roleId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>RolesClient.Get</c>.
+    /// </summary>
+    /// <remarks>Retrieve a role</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="roleId">The ID of the role to retrieve. Matches any value when null.</param>
+    public FakeRoute<Role> OnGet(string? merchantCode = null, string? roleId = null)
+    {
+        return _handler.Register<Role>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/roles/{role_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["role_id"] = roleId,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>RolesClient.List</c>.
+    /// </summary>
+    /// <remarks>List roles</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<RolesListResponse> OnList(string? merchantCode = null)
+    {
+        return _handler.Register<RolesListResponse>(
+            HttpMethod.Get,
+            "/v0.1/merchants/{merchant_code}/roles",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>RolesClient.Update</c>.
+    /// </summary>
+    /// <remarks>Update a role</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="roleId">The ID of the role to retrieve. Matches any value when null.</param>
+    public FakeRoute<Role> OnUpdate(string? merchantCode = null, string? roleId = null)
+    {
+        return _handler.Register<Role>(
+            new HttpMethod("PATCH"),
+            "/v0.1/merchants/{merchant_code}/roles/{role_id}",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["role_id"] = roleId,
+            });
+    }
+}
\ No newline at end of file
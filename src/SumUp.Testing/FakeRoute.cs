@@ -0,0 +1,232 @@
+using System;
+using System.Collections.Generic;
+using System.Net;
+using System.Net.Http;
+using System.Text;
+using System.Text.Json;
+using System.Threading;
+using SumUp.Http;
+
+namespace SumUp.Testing;
+
+/// <summary>
+/// A registered fake endpoint matched by HTTP method and path template.
+/// </summary>
+public abstract class FakeRoute
+{
+    private readonly string[] _segments;
+    private readonly IReadOnlyDictionary<string, string> _pathParameters;
+    private Func<JsonSerializerOptions, HttpResponseMessage>? _responseFactory;
+    private int _callCount;
+
+    private protected FakeRoute(
+        HttpMethod method,
+        string pathTemplate,
+        string contentType,
+        IReadOnlyDictionary<string, object?> pathParameters)
+    {
+        Method = method;
+        PathTemplate = pathTemplate;
+        ContentType = contentType;
+        _segments = pathTemplate.Trim('/').Split('/');
+
+        var values = new Dictionary<string, string>(StringComparer.OrdinalIgnoreCase);
+        foreach (var pair in pathParameters)
+        {
+            if (pair.Value is not null)
+            {
+                values[pair.Key] = RequestBuilder.ConvertToString(pair.Value);
+            }
+        }
+        _pathParameters = values;
+    }
+
+    /// <summary>
+    /// Gets the HTTP method served by the route.
+    /// </summary>
+    public HttpMethod Method { get; }
+
+    /// <summary>
+    /// Gets the OpenAPI path template served by the route, for example <c>/v0.1/checkouts/{id}</c>.
+    /// </summary>
+    public string PathTemplate { get; }
+
+    /// <summary>
+    /// Gets the content type used for successful responses.
+    /// </summary>
+    public string ContentType { get; }
+
+    /// <summary>
+    /// Gets the number of requests answered by the route.
+    /// </summary>
+    public int CallCount => Volatile.Read(ref _callCount);
+
+    internal bool Matches(HttpMethod method, string path)
+    {
+        if (method != Method)
+        {
+            return false;
+        }
+
+        var segments = path.Trim('/').Split('/');
+        if (segments.Length != _segments.Length)
+        {
+            return false;
+        }
+
+        for (var i = 0; i < segments.Length; i++)
+        {
+            var template = _segments[i];
+            var actual = Uri.UnescapeDataString(segments[i]);
+            if (template.Length > 2 && template[0] == '{' && template[^1] == '}')
+            {
+                var name = template.Substring(1, template.Length - 2);
+                if (_pathParameters.TryGetValue(name, out var expected) && !string.Equals(expected, actual, StringComparison.Ordinal))
+                {
+                    return false;
+                }
+                continue;
+            }
+
+            if (!string.Equals(template, actual, StringComparison.Ordinal))
+            {
+                return false;
+            }
+        }
+
+        return true;
+    }
+
+    internal HttpResponseMessage CreateResponse(JsonSerializerOptions serializerOptions)
+    {
+        Interlocked.Increment(ref _callCount);
+        if (_responseFactory is null)
+        {
+            return new HttpResponseMessage(HttpStatusCode.NoContent);
+        }
+        return _responseFactory(serializerOptions);
+    }
+
+    private protected void Respond(Func<JsonSerializerOptions, HttpResponseMessage> responseFactory)
+    {
+        _responseFactory = responseFactory;
+    }
+
+    // Returns a factory of the content of each response. HttpContent is disposed with the response that carries it,
+    // so a given instance is buffered once and copied for every call instead of being handed out again.
+    private protected static Func<JsonSerializerOptions, HttpContent?> ContentFactory(object? value, string contentType)
+    {
+        if (value is not HttpContent content)
+        {
+            return options => CreateContent(value, contentType, options);
+        }
+
+        var bytes = content.ReadAsByteArrayAsync().GetAwaiter().GetResult();
+        var headers = new List<KeyValuePair<string, IEnumerable<string>>>(content.Headers);
+        return _ =>
+        {
+            var copy = new ByteArrayContent(bytes);
+            foreach (var header in headers)
+            {
+                copy.Headers.TryAddWithoutValidation(header.Key, header.Value);
+            }
+            return copy;
+        };
+    }
+
+    private static HttpContent? CreateContent(object? value, string contentType, JsonSerializerOptions serializerOptions)
+    {
+        switch (value)
+        {
+            case null:
+                return null;
+            case string text when !contentType.Contains("json", StringComparison.OrdinalIgnoreCase):
+                return new StringContent(text, Encoding.UTF8, contentType);
+            case JsonDocument document:
+                return new StringContent(document.RootElement.GetRawText(), Encoding.UTF8, contentType);
+            default:
+                return new StringContent(JsonSerializer.Serialize(value, value.GetType(), serializerOptions), Encoding.UTF8, contentType);
+        }
+    }
+}
+
+/// <summary>
+/// A registered fake endpoint whose successful response deserializes to <typeparamref name="TResponse"/>.
+/// </summary>
+public sealed class FakeRoute<TResponse> : FakeRoute
+{
+    internal FakeRoute(
+        HttpMethod method,
+        string pathTemplate,
+        string contentType,
+        IReadOnlyDictionary<string, object?> pathParameters)
+        : base(method, pathTemplate, contentType, pathParameters)
+    {
+    }
+
+    /// <summary>
+    /// Answers matching requests with <paramref name="value"/> serialized like the real API would.
+    /// </summary>
+    public FakeRoute<TResponse> Returns(TResponse value, HttpStatusCode statusCode = HttpStatusCode.OK)
+    {
+        var content = ContentFactory(value, ContentType);
+        Respond(options => new HttpResponseMessage(statusCode)
+        {
+            Content = content(options),
+        });
+        return this;
+    }
+
+    /// <summary>
+    /// Answers matching requests with a raw JSON payload, for responses that set server-assigned read-only fields.
+    /// </summary>
+    public FakeRoute<TResponse> ReturnsJson(string json, HttpStatusCode statusCode = HttpStatusCode.OK)
+    {
+        if (json is null)
+        {
+            throw new ArgumentNullException(nameof(json));
+        }
+
+        Respond(_ => new HttpResponseMessage(statusCode)
+        {
+            Content = new StringContent(json, Encoding.UTF8, ContentType),
+        });
+        return this;
+    }
+
+    /// <summary>
+    /// Answers matching requests with an empty body and the given status code.
+    /// </summary>
+    public FakeRoute<TResponse> Returns(HttpStatusCode statusCode)
+    {
+        Respond(_ => new HttpResponseMessage(statusCode));
+        return this;
+    }
+
+    /// <summary>
+    /// Answers matching requests with an error status code and an optional error payload.
+    /// </summary>
+    public FakeRoute<TResponse> Fails(int statusCode, object? error = null)
+    {
+        var content = ContentFactory(error, "application/problem+json");
+        Respond(options => new HttpResponseMessage((HttpStatusCode)statusCode)
+        {
+            Content = content(options),
+        });
+        return this;
+    }
+
+    /// <summary>
+    /// Answers matching requests with a response built by <paramref name="responseFactory"/>.
+    /// </summary>
+    public FakeRoute<TResponse> Responds(Func<HttpResponseMessage> responseFactory)
+    {
+        if (responseFactory is null)
+        {
+            throw new ArgumentNullException(nameof(responseFactory));
+        }
+
+        Respond(_ => responseFactory());
+        return this;
+    }
+}
@@ -0,0 +1,145 @@
+using System;
+using System.Collections.Generic;
+using System.Net;
+using System.Net.Http;
+using System.Text;
+using System.Text.Json;
+using System.Text.Json.Serialization.Metadata;
+using System.Threading;
+using System.Threading.Tasks;
+using SumUp.Http;
+
+namespace SumUp.Testing;
+
+/// <summary>
+/// In-memory <see cref="HttpMessageHandler"/> that answers SDK requests from a typed route table.
+/// </summary>
+/// <remarks>
+/// Register responses through the per-client route properties (for example
+/// <c>fake.Checkouts.OnGet("chk_123").Returns(checkout)</c>) and inspect <see cref="Requests"/>
+/// to assert on what the code under test sent.
+/// </remarks>
+public sealed partial class FakeSumUpHandler : HttpMessageHandler
+{
+    /// <summary>
+    /// Base address used by clients created through <see cref="CreateClient"/>.
+    /// </summary>
+    public static readonly Uri DefaultBaseAddress = new("https://api.sumup.test/");
+
+    internal static readonly IReadOnlyDictionary<string, object?> NoPathParameters = new Dictionary<string, object?>();
+
+    private readonly object _gate = new();
+    private readonly List<FakeRoute> _routes = new();
+    private readonly List<RecordedRequest> _requests = new();
+
+    public FakeSumUpHandler()
+    {
+        SerializerOptions = ApiClient.CreateSerializerOptions();
+        // Tests may return and read types the SDK does not serialize, so fall back to reflection.
+        SerializerOptions.TypeInfoResolverChain.Add(new DefaultJsonTypeInfoResolver());
+        InitializeGeneratedRoutes();
+    }
+
+    partial void InitializeGeneratedRoutes();
+
+    /// <summary>
+    /// Gets the serializer options used to encode fake responses. They match the options used by <see cref="SumUpClient"/>.
+    /// </summary>
+    public JsonSerializerOptions SerializerOptions { get; }
+
+    /// <summary>
+    /// Gets the requests received so far, in the order they were sent.
+    /// </summary>
+    public IReadOnlyList<RecordedRequest> Requests
+    {
+        get
+        {
+            lock (_gate)
+            {
+                return _requests.ToArray();
+            }
+        }
+    }
+
+    /// <summary>
+    /// Creates a <see cref="SumUpClient"/> whose requests are served by this handler.
+    /// </summary>
+    public SumUpClient CreateClient(SumUpClientOptions? options = null)
+    {
+        options ??= new SumUpClientOptions();
+        options.AccessToken ??= "fake-access-token";
+        options.HttpClient = new HttpClient(this, disposeHandler: false)
+        {
+            BaseAddress = DefaultBaseAddress,
+        };
+        return new SumUpClient(options);
+    }
+
+    /// <summary>
+    /// Removes every registered route and recorded request.
+    /// </summary>
+    public void Reset()
+    {
+        lock (_gate)
+        {
+            _routes.Clear();
+            _requests.Clear();
+        }
+    }
+
+    internal FakeRoute<TResponse> Register<TResponse>(
+        HttpMethod method,
+        string pathTemplate,
+        string contentType,
+        IReadOnlyDictionary<string, object?> pathParameters)
+    {
+        var route = new FakeRoute<TResponse>(method, pathTemplate, contentType, pathParameters);
+        lock (_gate)
+        {
+            _routes.Add(route);
+        }
+        return route;
+    }
+
+    /// <inheritdoc />
+    protected override async Task<HttpResponseMessage> SendAsync(HttpRequestMessage request, CancellationToken cancellationToken)
+    {
+        cancellationToken.ThrowIfCancellationRequested();
+
+        var body = request.Content is null
+            ? null
+            : await ApiClient.ReadContentAsStringAsync(request.Content, cancellationToken).ConfigureAwait(false);
+        var recorded = new RecordedRequest(request, body, SerializerOptions);
+
+        FakeRoute? match = null;
+        lock (_gate)
+        {
+            _requests.Add(recorded);
+            // Later registrations win so tests can override a default set up earlier.
+            for (var i = _routes.Count - 1; i >= 0; i--)
+            {
+                if (_routes[i].Matches(request.Method, recorded.Path))
+                {
+                    match = _routes[i];
+                    break;
+                }
+            }
+        }
+
+        if (match is null)
+        {
+            return new HttpResponseMessage(HttpStatusCode.NotImplemented)
+            {
+                RequestMessage = request,
+                Content = new StringContent(
+                    $"No fake route registered for {request.Method} {recorded.Path}.",
+                    Encoding.UTF8,
+                    "text/plain"),
+            };
+        }
+
+        var response = match.CreateResponse(SerializerOptions);
+        response.RequestMessage = request;
+        return response;
+    }
+}
@@ -0,0 +1,71 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+public sealed partial class FakeSumUpHandler
+{
+    partial void InitializeGeneratedRoutes()
+    {
+        Checkouts = new FakeCheckoutsRoutes(this);
+        Customers = new FakeCustomersRoutes(this);
+        Members = new FakeMembersRoutes(this);
+        Memberships = new FakeMembershipsRoutes(this);
+        Merchants = new FakeMerchantsRoutes(this);
+        Payouts = new FakePayoutsRoutes(this);
+        Readers = new FakeReadersRoutes(this);
+        Receipts = new FakeReceiptsRoutes(this);
+        Roles = new FakeRolesRoutes(this);
+        Transactions = new FakeTransactionsRoutes(this);
+    }
+
+    /// <summary>
+    /// Fake routes for the Checkouts API endpoints.
+    /// </summary>
+    public FakeCheckoutsRoutes Checkouts { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Customers API endpoints.
+    /// </summary>
+    public FakeCustomersRoutes Customers { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Members API endpoints.
+    /// </summary>
+    public FakeMembersRoutes Members { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Memberships API endpoints.
+    /// </summary>
+    public FakeMembershipsRoutes Memberships { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Merchants API endpoints.
+    /// </summary>
+    public FakeMerchantsRoutes Merchants { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Payouts API endpoints.
+    /// </summary>
+    public FakePayoutsRoutes Payouts { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Readers API endpoints.
+    /// </summary>
+    public FakeReadersRoutes Readers { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Receipts API endpoints.
+    /// </summary>
+    public FakeReceiptsRoutes Receipts { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Roles API endpoints.
+    /// </summary>
+    public FakeRolesRoutes Roles { get; private set; } = default!;
+
+    /// <summary>
+    /// Fake routes for the Transactions API endpoints.
+    /// </summary>
+    public FakeTransactionsRoutes Transactions { get; private set; } = default!;
+}
\ No newline at end of file
@@ -0,0 +1,74 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp.Testing;
+
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+
+/// <summary>
+/// Fake routes for the Transactions API endpoints.
+/// </summary>
+public sealed partial class FakeTransactionsRoutes
+{
+    private readonly FakeSumUpHandler _handler;
+
+    internal FakeTransactionsRoutes(FakeSumUpHandler handler)
+    {
+        _handler = handler;
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>TransactionsClient.Get</c>.
+    /// </summary>
+    /// <remarks>Retrieve a transaction</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<TransactionFull> OnGet(string? merchantCode = null)
+    {
+        return _handler.Register<TransactionFull>(
+            HttpMethod.Get,
+            "/v2.1/merchants/{merchant_code}/transactions",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>TransactionsClient.List</c>.
+    /// </summary>
+    /// <remarks>List transactions</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    public FakeRoute<TransactionsListResponse> OnList(string? merchantCode = null)
+    {
+        return _handler.Register<TransactionsListResponse>(
+            HttpMethod.Get,
+            "/v2.1/merchants/{merchant_code}/transactions/history",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+            });
+    }
+
+    /// <summary>
+    /// Registers a fake response for <c>TransactionsClient.Refund</c>.
+    /// </summary>
+    /// <remarks>Refund a transaction</remarks>
+    /// <param name="merchantCode">Short unique identifier for the merchant. Matches any value when null.</param>
+    /// <param name="transactionId">Unique identifier of the transaction. Matches any value when null.</param>
+    public FakeRoute<JsonDocument> OnRefund(string? merchantCode = null, string? transactionId = null)
+    {
+        return _handler.Register<JsonDocument>(
+            HttpMethod.Post,
+            "/v1.0/merchants/{merchant_code}/payments/{transaction_id}/refunds",
+            "application/json",
+            new Dictionary<string, object?>
+            {
+                ["merchant_code"] = merchantCode,
+                ["transaction_id"] = transactionId,
+            });
+    }
+}
\ No newline at end of file
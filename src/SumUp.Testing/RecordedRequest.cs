@@ -0,0 +1,101 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Http;
+using System.Text.Json;
+
+namespace SumUp.Testing;
+
+/// <summary>
+/// Snapshot of a request received by <see cref="FakeSumUpHandler"/>.
+/// </summary>
+public sealed class RecordedRequest
+{
+    private readonly JsonSerializerOptions _serializerOptions;
+
+    internal RecordedRequest(HttpRequestMessage request, string? body, JsonSerializerOptions serializerOptions)
+    {
+        _serializerOptions = serializerOptions;
+        Method = request.Method;
+        RequestUri = request.RequestUri ?? throw new ArgumentException("Request URI is required.", nameof(request));
+        Path = RequestUri.IsAbsoluteUri ? RequestUri.AbsolutePath : RequestUri.OriginalString.Split('?')[0];
+        Body = body;
+        ContentType = request.Content?.Headers.ContentType?.MediaType;
+
+        var headers = new Dictionary<string, IReadOnlyList<string>>(StringComparer.OrdinalIgnoreCase);
+        foreach (var header in request.Headers)
+        {
+            headers[header.Key] = header.Value.ToArray();
+        }
+        Headers = headers;
+        Query = ParseQuery(RequestUri);
+    }
+
+    /// <summary>
+    /// Gets the HTTP method of the request.
+    /// </summary>
+    public HttpMethod Method { get; }
+
+    /// <summary>
+    /// Gets the full request URI.
+    /// </summary>
+    public Uri RequestUri { get; }
+
+    /// <summary>
+    /// Gets the escaped request path without the query string.
+    /// </summary>
+    public string Path { get; }
+
+    /// <summary>
+    /// Gets the decoded query parameters. Repeated parameters keep every value in order.
+    /// </summary>
+    public IReadOnlyDictionary<string, IReadOnlyList<string>> Query { get; }
+
+    /// <summary>
+    /// Gets the request headers, excluding content headers.
+    /// </summary>
+    public IReadOnlyDictionary<string, IReadOnlyList<string>> Headers { get; }
+
+    /// <summary>
+    /// Gets the media type of the request body, if any.
+    /// </summary>
+    public string? ContentType { get; }
+
+    /// <summary>
+    /// Gets the raw request body, if any.
+    /// </summary>
+    public string? Body { get; }
+
+    /// <summary>
+    /// Deserializes the request body using the SDK serializer options.
+    /// </summary>
+    public T? ReadBody<T>()
+    {
+        if (string.IsNullOrEmpty(Body))
+        {
+            return default;
+        }
+
+        return JsonSerializer.Deserialize<T>(Body!, _serializerOptions);
+    }
+
+    private static IReadOnlyDictionary<string, IReadOnlyList<string>> ParseQuery(Uri uri)
+    {
+        var values = new Dictionary<string, List<string>>(StringComparer.Ordinal);
+        var query = uri.IsAbsoluteUri ? uri.Query : string.Empty;
+        foreach (var pair in query.TrimStart('?').Split('&', StringSplitOptions.RemoveEmptyEntries))
+        {
+            var separator = pair.IndexOf('=');
+            var key = Uri.UnescapeDataString(separator < 0 ? pair : pair.Substring(0, separator));
+            var value = separator < 0 ? string.Empty : Uri.UnescapeDataString(pair.Substring(separator + 1));
+            if (!values.TryGetValue(key, out var list))
+            {
+                list = new List<string>();
+                values[key] = list;
+            }
+            list.Add(value);
+        }
+
+        return values.ToDictionary(pair => pair.Key, pair => (IReadOnlyList<string>)pair.Value, StringComparer.Ordinal);
+    }
+}
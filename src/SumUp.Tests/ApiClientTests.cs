@@ -0,0 +1,30 @@
+using System;
+using System.IO;
+using System.Net.Http;
+using System.Text;
+using SumUp.Http;
+using Xunit;
+
+namespace SumUp.Tests;
+
+public class ApiClientTests
+{
+    [Fact]
+    public void CreateContent_SerializesTheDeclaredBodyType()
+    {
+        using var httpClient = new HttpClient { BaseAddress = new Uri("https://api.sumup.com") };
+        var apiClient = new ApiClient(httpClient, new SumUpClientOptions());
+        Error body = new CallerError { Message = "declined", Extra = "ignored" };
+
+        using var content = apiClient.CreateContent(body, "application/json");
+        using var stream = content.ReadAsStream();
+        using var reader = new StreamReader(stream, Encoding.UTF8);
+
+        Assert.Equal("{\"error_code\":null,\"message\":\"declined\"}", reader.ReadToEnd());
+    }
+
+    private sealed class CallerError : Error
+    {
+        public string? Extra { get; set; }
+    }
+}
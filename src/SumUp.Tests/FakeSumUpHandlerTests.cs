@@ -0,0 +1,108 @@
+using System;
+using System.Net;
+using System.Net.Http;
+using System.Text;
+using System.Threading.Tasks;
+using SumUp.Http;
+using SumUp.Testing;
+using Xunit;
+
+namespace SumUp.Tests;
+
+public class FakeSumUpHandlerTests
+{
+    [Fact]
+    public async Task Returns_SerializesResponseForMatchingRoute()
+    {
+        using var fake = new FakeSumUpHandler();
+        fake.Readers.OnGet("merchant-123", "rdr_123").Returns(new Reader
+        {
+            Id = "rdr_123",
+            Name = "READER01",
+            Status = ReaderStatus.Paired,
+            Device = new ReaderDevice { Identifier = "device-123", Model = ReaderDeviceModel.Solo },
+        });
+        using var client = fake.CreateClient();
+
+        var response = await client.Readers.GetAsync("merchant-123", "rdr_123");
+
+        Assert.Equal(HttpStatusCode.OK, response.StatusCode);
+        var reader = Assert.IsType<Reader>(response.Data);
+        Assert.Equal("rdr_123", reader.Id);
+        Assert.Equal(ReaderStatus.Paired, reader.Status);
+    }
+
+    [Fact]
+    public async Task Fails_ThrowsTypedApiException()
+    {
+        using var fake = new FakeSumUpHandler();
+        fake.Readers.OnGet().Fails(404, new Problem { Type = "https://developer.sumup.com/problem/not-found", Title = "Not Found" });
+        using var client = fake.CreateClient();
+
+        var exception = await Assert.ThrowsAsync<ApiException<Problem>>(
+            () => client.Readers.GetAsync("merchant-123", "rdr_missing"));
+
+        Assert.Equal(HttpStatusCode.NotFound, exception.StatusCode);
+        Assert.Equal("Not Found", exception.Error?.Title);
+    }
+
+    [Fact]
+    public async Task Fails_ReplaysHttpContentOnEveryCall()
+    {
+        using var fake = new FakeSumUpHandler();
+        var route = fake.Readers.OnGet().Fails(
+            404,
+            new StringContent("""{"title":"Not Found"}""", Encoding.UTF8, "application/problem+json"));
+        using var client = fake.CreateClient();
+
+        for (var call = 0; call < 2; call++)
+        {
+            var exception = await Assert.ThrowsAsync<ApiException<Problem>>(
+                () => client.Readers.GetAsync("merchant-123", "rdr_missing"));
+            Assert.Equal("Not Found", exception.Error?.Title);
+        }
+
+        Assert.Equal(2, route.CallCount);
+    }
+
+    [Fact]
+    public async Task Routes_MatchPathParametersAndRecordRequests()
+    {
+        using var fake = new FakeSumUpHandler();
+        fake.Readers.OnUpdate("merchant-123", "rdr_other").Returns(new Reader { Id = "rdr_other" });
+        var route = fake.Readers.OnUpdate("merchant-123", "rdr_123").Returns(new Reader { Id = "rdr_123" });
+        using var client = fake.CreateClient();
+
+        await client.Readers.UpdateAsync("merchant-123", "rdr_123", new ReadersUpdateRequest { Name = "Front desk" });
+
+        Assert.Equal(1, route.CallCount);
+        var request = Assert.Single(fake.Requests);
+        Assert.Equal(new HttpMethod("PATCH"), request.Method);
+        Assert.Equal("/v0.1/merchants/merchant-123/readers/rdr_123", request.Path);
+        Assert.Equal("Front desk", request.ReadBody<ReadersUpdateRequest>()?.Name);
+    }
+
+    [Fact]
+    public async Task ReturnsJson_PopulatesReadOnlyProperties()
+    {
+        using var fake = new FakeSumUpHandler();
+        fake.Checkouts.OnGet("chk_123").ReturnsJson("""{"id":"chk_123","status":"PAID"}""");
+        using var client = fake.CreateClient();
+
+        var response = await client.Checkouts.GetAsync("chk_123");
+
+        Assert.Equal("chk_123", response.Data?.Id);
+    }
+
+    [Fact]
+    public async Task UnmatchedRequests_FailWithNotImplemented()
+    {
+        using var fake = new FakeSumUpHandler();
+        using var client = fake.CreateClient();
+
+        var exception = await Assert.ThrowsAnyAsync<ApiException>(() => client.Readers.ListAsync("merchant-123"));
+
+        Assert.Equal(HttpStatusCode.NotImplemented, exception.StatusCode);
+        Assert.Single(fake.Requests);
+    }
+}
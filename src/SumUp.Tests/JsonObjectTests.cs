@@ -3,6 +3,7 @@ using System.Collections.Generic;
 using System.IO;
 using System.Net.Http;
 using System.Text;
+using System.Text.Json;
 using SumUp.Http;
 using Xunit;
 
@@ -66,4 +67,81 @@ public class JsonObjectTests
         var nested = Assert.IsType<JsonObject>(items[1]);
         Assert.True(nested.GetValue<bool>("nested"));
     }
+
+    [Fact]
+    public void Serialize_WritesValuesWithSourceGeneratedMetadata()
+    {
+        var value = new JsonObject
+        {
+            ["amount"] = 1.5,
+            ["count"] = 2L,
+            ["sandbox"] = true,
+            ["token"] = new JsonObject
+            {
+                ["version"] = "EC_v1"
+            },
+            ["items"] = new List<object?> { "one", 2, null },
+        };
+
+        var json = JsonSerializer.Serialize(value, SumUpJsonContext.Default.JsonObject);
+
+        Assert.Equal(
+            "{\"amount\":1.5,\"count\":2,\"sandbox\":true,\"token\":{\"version\":\"EC_v1\"},\"items\":[\"one\",2,null]}",
+            json);
+        var roundTripped = JsonSerializer.Deserialize(json, SumUpJsonContext.Default.JsonObject);
+        Assert.Equal(json, JsonSerializer.Serialize(roundTripped!, SumUpJsonContext.Default.JsonObject));
+    }
+
+    [Fact]
+    public void Serialize_WritesValuesWithoutSourceGeneratedMetadata()
+    {
+        var value = new JsonObject
+        {
+            ["labels"] = new Dictionary<string, string> { ["color"] = "blue" },
+            ["id"] = new Guid("5b9e0b8c-1f0e-4c1d-9a7e-3c4b2a1d0e9f"),
+            ["small"] = (short)7,
+            ["payload"] = new byte[] { 1, 2, 3 },
+            ["uri"] = new Uri("https://example.com/"),
+        };
+
+        var json = JsonSerializer.Serialize(value, SumUpJsonContext.Default.JsonObject);
+
+        Assert.Equal(
+            "{\"labels\":{\"color\":\"blue\"},\"id\":\"5b9e0b8c-1f0e-4c1d-9a7e-3c4b2a1d0e9f\",\"small\":7,\"payload\":\"AQID\",\"uri\":\"https://example.com/\"}",
+            json);
+    }
+
+    [Fact]
+    public void Serialize_WritesTypesOutsideTheContextWithReflection()
+    {
+        var value = new JsonObject
+        {
+            ["order"] = new OrderMetadata { Reference = "order-1", Quantity = 2 },
+            ["source"] = new { Channel = "web" },
+        };
+
+        var json = JsonSerializer.Serialize(value, SumUpJsonContext.Default.JsonObject);
+
+        Assert.Equal("{\"order\":{\"reference\":\"order-1\",\"quantity\":2},\"source\":{\"channel\":\"web\"}}", json);
+    }
+
+    private sealed class OrderMetadata
+    {
+        public string? Reference { get; set; }
+
+        public int Quantity { get; set; }
+    }
+
+    [Fact]
+    public void GetValue_ConvertsWithTypeInfo()
+    {
+        var value = new JsonObject
+        {
+            ["token"] = new Dictionary<string, object?> { ["version"] = "EC_v1" },
+        };
+
+        var token = value.GetValue("token", SumUpJsonContext.Default.JsonObject);
+
+        Assert.Equal("EC_v1", Assert.IsType<string>(token!["version"]));
+    }
 }
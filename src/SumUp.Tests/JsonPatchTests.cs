@@ -0,0 +1,62 @@
+using System;
+using System.Collections.Generic;
+using Xunit;
+using JsonNodeObject = System.Text.Json.Nodes.JsonObject;
+
+namespace SumUp.Tests;
+
+public class JsonPatchTests
+{
+    private static readonly Reader Original = new()
+    {
+        Id = "rdr_123",
+        Name = "Front desk",
+        ServiceAccountId = new Guid("9f5a8b3e-0c5d-4d6e-8f4a-2b1c3d4e5f60"),
+    };
+
+    private static readonly Reader Modified = new()
+    {
+        Id = "rdr_123",
+        Name = "Back office",
+        ServiceAccountId = null,
+    };
+
+    [Fact]
+    public void MergePatch_ContainsChangedFieldsOnly()
+    {
+        var patch = TestMergePatch.From(Original, Modified);
+
+        Assert.Equal("{\"name\":\"Back office\",\"service_account_id\":null}", patch.ToString());
+        Assert.True(TestMergePatch.From(Original, Original).IsEmpty);
+    }
+
+    [Fact]
+    public void JsonPatch_ReplacesChangedValues()
+    {
+        var patch = TestJsonPatch.From(Original, Modified);
+
+        Assert.Equal(
+            "[{\"op\":\"replace\",\"path\":\"/name\",\"value\":\"Back office\"},{\"op\":\"replace\",\"path\":\"/service_account_id\",\"value\":null}]",
+            patch.ToString());
+    }
+
+    private sealed class TestMergePatch : JsonMergePatch
+    {
+        private TestMergePatch(JsonNodeObject changes)
+            : base(changes)
+        {
+        }
+
+        public static TestMergePatch From(Reader original, Reader modified) => new(Diff(original, modified));
+    }
+
+    private sealed class TestJsonPatch : JsonPatch
+    {
+        private TestJsonPatch(IReadOnlyList<JsonPatchOperation> operations)
+            : base(operations)
+        {
+        }
+
+        public static TestJsonPatch From(Reader original, Reader modified) => new(Diff(original, modified));
+    }
+}
@@ -58,4 +58,24 @@ public class ModelDeserializationTests
         Assert.NotNull(personalDetails);
         Assert.Equal(new DateOnly(1980, 1, 12), personalDetails!.BirthDate);
     }
+
+    [Fact]
+    public void Checkout_IsDeserializedWithSourceGeneratedMetadata()
+    {
+        const string json = """
+            {
+              "id": "chk_123",
+              "currency": "EUR",
+              "status": "paid"
+            }
+            """;
+
+        var checkout = JsonSerializer.Deserialize(json, SumUpJsonContext.Default.Checkout);
+
+        Assert.NotNull(checkout);
+        Assert.Equal("chk_123", checkout!.Id);
+        Assert.Equal(Currency.Eur, checkout.Currency);
+        Assert.Equal(CheckoutStatus.Paid, checkout.Status);
+        Assert.Contains("\"status\":\"PAID\"", JsonSerializer.Serialize(checkout, SumUpJsonContext.Default.Checkout));
+    }
 }
@@ -0,0 +1,61 @@
+using System;
+using System.IO;
+using System.Net.Http;
+using System.Text;
+using System.Text.Json;
+using SumUp.Http;
+using Xunit;
+
+namespace SumUp.Tests;
+
+public class OptionalTests
+{
+    [Fact]
+    public void CreateContent_OmitsUnsetPropertiesAndWritesExplicitNulls()
+    {
+        using var httpClient = new HttpClient { BaseAddress = new Uri("https://api.sumup.com") };
+        var apiClient = new ApiClient(httpClient, new SumUpClientOptions());
+        var request = new RolesUpdateRequest
+        {
+            Name = "Shop Manager",
+            Description = null,
+        };
+
+        using var content = apiClient.CreateContent(request, "application/json");
+        using var stream = content.ReadAsStream();
+        using var reader = new StreamReader(stream, Encoding.UTF8);
+        var body = reader.ReadToEnd();
+
+        Assert.Equal("{\"description\":null,\"name\":\"Shop Manager\"}", body);
+    }
+
+    [Fact]
+    public void Deserialize_DistinguishesMissingFromNullProperties()
+    {
+        var request = JsonSerializer.Deserialize<ReadersUpdateRequest>("""{"metadata":null}""");
+
+        Assert.NotNull(request);
+        Assert.True(request!.Metadata.IsNull);
+        Assert.False(request.Name.IsSet);
+        Assert.Throws<InvalidOperationException>(() => request.Name.Value);
+    }
+
+    [Fact]
+    public void Serialize_UsesClosedConvertersWithSourceGeneratedMetadata()
+    {
+        var request = new CheckoutUpdateRequest
+        {
+            Amount = 2.5f,
+            Currency = Currency.Eur,
+            Description = null,
+        };
+
+        var json = JsonSerializer.Serialize(request, SumUpJsonContext.Default.CheckoutUpdateRequest);
+        var roundTripped = JsonSerializer.Deserialize(json, SumUpJsonContext.Default.CheckoutUpdateRequest);
+
+        Assert.Equal("{\"amount\":2.5,\"currency\":\"EUR\",\"description\":null}", json);
+        Assert.Equal(Currency.Eur, roundTripped!.Currency.Value);
+        Assert.True(roundTripped.Description.IsNull);
+        Assert.False(roundTripped.CustomerId.IsSet);
+    }
+}
@@ -2,6 +2,7 @@ using System;
 using System.IO;
 using System.Net.Http;
 using System.Text;
+using System.Text.Json;
 using SumUp.Http;
 using Xunit;
 
@@ -80,6 +81,42 @@ public class RequestBuilderTests
         Assert.Equal("https://api.sumup.com/v0.1/items?birthdate=1980-01-12", request.RequestUri!.AbsoluteUri);
     }
 
+    [Fact]
+    public void Build_OmitsQueryParametersEqualToTheirDefaultWhenConfigured()
+    {
+        var builder = new RequestBuilder(HttpMethod.Get, "/v0.1/items", new Uri("https://api.sumup.com"), omitDefaultParameters: true);
+        builder.AddQuery("limit", (int?)10, 10);
+        builder.AddQuery("offset", (int?)20, 0);
+        var request = builder.Build();
+
+        Assert.Equal("https://api.sumup.com/v0.1/items?offset=20", request.RequestUri!.AbsoluteUri);
+    }
+
+    [Fact]
+    public void Build_KeepsDefaultQueryParametersByDefault()
+    {
+        var builder = new RequestBuilder(HttpMethod.Get, "/v0.1/items", new Uri("https://api.sumup.com"));
+        builder.AddQuery("limit", (int?)10, 10);
+        var request = builder.Build();
+
+        Assert.Equal("https://api.sumup.com/v0.1/items?limit=10", request.RequestUri!.AbsoluteUri);
+    }
+
+    [Fact]
+    public void Build_SendsNoQueryForDefaultOptions()
+    {
+        var options = new MembersListOptions();
+        var builder = new RequestBuilder(HttpMethod.Get, "/v0.1/merchants/{merchant_code}/members", new Uri("https://api.sumup.com"));
+        builder.AddPath("merchant_code", "MH4H92C7");
+        builder.AddQuery("offset", options.Offset, 0);
+        builder.AddQuery("limit", options.Limit, 10);
+        builder.AddQuery("scroll", options.Scroll, false);
+        builder.AddQuery("email", options.Email);
+        var request = builder.Build();
+
+        Assert.Equal("https://api.sumup.com/v0.1/merchants/MH4H92C7/members", request.RequestUri!.AbsoluteUri);
+    }
+
     [Fact]
     public void CreateContent_SerializesEnumMemberValues()
     {
@@ -120,6 +157,42 @@ public class RequestBuilderTests
         Assert.Contains("\"birth_date\":\"1980-01-12\"", body);
     }
 
+    [Fact]
+    public void CreateContent_SendsOnlyValuesTheCallerSet()
+    {
+        using var httpClient = new HttpClient { BaseAddress = new Uri("https://api.sumup.com") };
+        var apiClient = new ApiClient(httpClient, new SumUpClientOptions());
+        var request = new CreateReaderCheckoutRequest
+        {
+            TotalAmount = new CreateReaderCheckoutRequestTotalAmount { Currency = "EUR", MinorUnit = 2, Value = 1000 },
+        };
+
+        using var content = apiClient.CreateContent(request, "application/json");
+        using var stream = content.ReadAsStream();
+        using var document = JsonDocument.Parse(stream);
+
+        foreach (var property in document.RootElement.EnumerateObject())
+        {
+            if (property.Name != "total_amount")
+            {
+                Assert.True(property.Value.ValueKind == JsonValueKind.Null, $"{property.Name} was sent as {property.Value}");
+            }
+        }
+    }
+
+    [Fact]
+    public void CreateContent_SerializesTypesOutsideTheContext()
+    {
+        using var httpClient = new HttpClient { BaseAddress = new Uri("https://api.sumup.com") };
+        var apiClient = new ApiClient(httpClient, new SumUpClientOptions());
+
+        using var content = apiClient.CreateContent(new CustomBody { OrderReference = "order-1" }, "application/json");
+        using var stream = content.ReadAsStream();
+        using var reader = new StreamReader(stream, Encoding.UTF8);
+
+        Assert.Equal("{\"orderReference\":\"order-1\"}", reader.ReadToEnd());
+    }
+
     [Fact]
     public void TryDeserialize_ReturnsDefaultForMalformedJson()
     {
@@ -139,4 +212,9 @@ public class RequestBuilderTests
 
         Assert.Throws<NotSupportedException>(() => apiClient.TryDeserialize<Type>("\"System.String\""));
     }
+
+    private sealed class CustomBody
+    {
+        public string? OrderReference { get; set; }
+    }
 }
@@ -53,6 +53,25 @@ public class SumUpClientOptionsTests
         }
     }
 
+    [Fact]
+    public void BaseAddress_ComesFromEnvironment()
+    {
+        const string variable = "SUMUP_BASE_URL";
+        var originalValue = Environment.GetEnvironmentVariable(variable);
+        Environment.SetEnvironmentVariable(variable, "http://localhost:4010/");
+
+        try
+        {
+            var options = SumUpClientOptions.FromEnvironment();
+
+            Assert.Equal(new Uri("http://localhost:4010/"), options.BaseAddress);
+        }
+        finally
+        {
+            Environment.SetEnvironmentVariable(variable, originalValue);
+        }
+    }
+
     [Fact]
     public void JsonSerializer_UsesEnumMemberValueForStandaloneEnums()
     {
@@ -80,7 +80,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Checkout>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Checkout>());
             return ApiResponse<Checkout>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -145,7 +145,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Checkout>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Checkout>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Checkout>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -322,7 +322,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Checkout>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Checkout>());
             return ApiResponse<Checkout>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -381,7 +381,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Checkout>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Checkout>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Checkout>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -435,7 +435,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<CheckoutSuccess>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<CheckoutSuccess>());
             return ApiResponse<CheckoutSuccess>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -489,7 +489,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<CheckoutSuccess>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<CheckoutSuccess>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<CheckoutSuccess>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -539,7 +539,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<IEnumerable<CheckoutSuccess>>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<IEnumerable<CheckoutSuccess>>());
             return ApiResponse<IEnumerable<CheckoutSuccess>>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -589,7 +589,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<IEnumerable<CheckoutSuccess>>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<IEnumerable<CheckoutSuccess>>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<IEnumerable<CheckoutSuccess>>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -642,7 +642,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<CheckoutsListAvailablePaymentMethodsResponse>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<CheckoutsListAvailablePaymentMethodsResponse>());
             return ApiResponse<CheckoutsListAvailablePaymentMethodsResponse>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -695,7 +695,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<CheckoutsListAvailablePaymentMethodsResponse>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<CheckoutsListAvailablePaymentMethodsResponse>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<CheckoutsListAvailablePaymentMethodsResponse>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -754,7 +754,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Checkout>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Checkout>());
             return ApiResponse<Checkout>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -813,7 +813,7 @@ public sealed partial class CheckoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Checkout>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Checkout>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Checkout>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
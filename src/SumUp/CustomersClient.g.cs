@@ -80,7 +80,7 @@ public sealed partial class CustomersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Customer>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Customer>());
             return ApiResponse<Customer>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -145,7 +145,7 @@ public sealed partial class CustomersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Customer>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Customer>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Customer>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -332,7 +332,7 @@ public sealed partial class CustomersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Customer>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Customer>());
             return ApiResponse<Customer>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -391,7 +391,7 @@ public sealed partial class CustomersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Customer>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Customer>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Customer>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -450,7 +450,7 @@ public sealed partial class CustomersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<IEnumerable<PaymentInstrumentResponse>>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<IEnumerable<PaymentInstrumentResponse>>());
             return ApiResponse<IEnumerable<PaymentInstrumentResponse>>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -509,7 +509,7 @@ public sealed partial class CustomersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<IEnumerable<PaymentInstrumentResponse>>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<IEnumerable<PaymentInstrumentResponse>>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<IEnumerable<PaymentInstrumentResponse>>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -573,7 +573,7 @@ public sealed partial class CustomersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Customer>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Customer>());
             return ApiResponse<Customer>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -637,7 +637,7 @@ public sealed partial class CustomersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Customer>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Customer>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Customer>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
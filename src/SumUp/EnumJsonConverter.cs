@@ -0,0 +1,85 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace SumUp;
+
+/// <summary>
+/// Serializes an enum using the values its generated subclass maps the members to. Unlike
+/// <see cref="EnumMemberJsonConverterFactory"/> it needs no reflection, so it is safe to trim and to compile
+/// with NativeAOT.
+/// </summary>
+internal abstract class EnumJsonConverter<TEnum> : JsonConverter<TEnum>
+    where TEnum : struct, Enum
+{
+    private readonly IReadOnlyDictionary<TEnum, string> _writeMappings;
+    private readonly Dictionary<string, TEnum> _readMappings = new(StringComparer.OrdinalIgnoreCase);
+
+    protected EnumJsonConverter(IReadOnlyDictionary<TEnum, string> values)
+    {
+        _writeMappings = values;
+        foreach (var pair in values)
+        {
+            _readMappings[pair.Value] = pair.Key;
+        }
+
+        foreach (var value in values.Keys)
+        {
+            _readMappings[value.ToString()] = value;
+        }
+    }
+
+    public override TEnum Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)
+    {
+        if (reader.TokenType == JsonTokenType.String)
+        {
+            var value = reader.GetString();
+            if (value is not null)
+            {
+                if (_readMappings.TryGetValue(value, out var mapped))
+                {
+                    return mapped;
+                }
+
+                if (Enum.TryParse<TEnum>(value, ignoreCase: true, out var parsed))
+                {
+                    return parsed;
+                }
+            }
+        }
+        else if (reader.TokenType == JsonTokenType.Number && reader.TryGetInt64(out var number))
+        {
+            if (Enum.TryParse<TEnum>(number.ToString(CultureInfo.InvariantCulture), out var parsed))
+            {
+                return parsed;
+            }
+        }
+
+        throw new JsonException(
+            string.Format(
+                CultureInfo.InvariantCulture,
+                "Unable to convert value to enum type '{0}'.",
+                typeof(TEnum).FullName));
+    }
+
+    public override void Write(Utf8JsonWriter writer, TEnum value, JsonSerializerOptions options)
+    {
+        if (_writeMappings.TryGetValue(value, out var mapped))
+        {
+            writer.WriteStringValue(mapped);
+            return;
+        }
+
+        // Values without a member format as their number.
+        var name = value.ToString();
+        if (long.TryParse(name, NumberStyles.Integer, CultureInfo.InvariantCulture, out var number))
+        {
+            writer.WriteNumberValue(number);
+            return;
+        }
+
+        writer.WriteStringValue(name);
+    }
+}
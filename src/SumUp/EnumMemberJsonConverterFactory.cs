@@ -1,7 +1,7 @@
 using System;
 using System.Collections.Generic;
+using System.Diagnostics.CodeAnalysis;
 using System.Globalization;
-using System.Linq;
 using System.Reflection;
 using System.Runtime.Serialization;
 using System.Text.Json;
@@ -11,8 +11,12 @@ namespace SumUp;
 
 /// <summary>
 /// Serializes enums using <see cref="EnumMemberAttribute.Value"/> when present.
+/// Generated enums use generated converters instead, since this factory reads the members by reflection and so
+/// cannot be trimmed or compiled with NativeAOT.
 /// Replace this with built-in enum member name support once the SDK targets a runtime that supports it directly.
 /// </summary>
+[RequiresUnreferencedCode("The enum members are read with reflection. Use a generated enum converter when trimming.")]
+[RequiresDynamicCode("The converters are created with reflection. Use a generated enum converter when compiling with NativeAOT.")]
 public sealed class EnumMemberJsonConverterFactory : JsonConverterFactory
 {
     /// <inheritdoc />
@@ -34,7 +38,7 @@ public sealed class EnumMemberJsonConverterFactory : JsonConverterFactory
         return (JsonConverter)Activator.CreateInstance(converterType)!;
     }
 
-    private sealed class NullableEnumMemberJsonConverter<TEnum> : JsonConverter<TEnum?>
+    private sealed class NullableEnumMemberJsonConverter<[DynamicallyAccessedMembers(DynamicallyAccessedMemberTypes.PublicFields)] TEnum> : JsonConverter<TEnum?>
         where TEnum : struct, Enum
     {
         private static readonly EnumMemberJsonConverter<TEnum> InnerConverter = new();
@@ -61,7 +65,7 @@ public sealed class EnumMemberJsonConverterFactory : JsonConverterFactory
         }
     }
 
-    private sealed class EnumMemberJsonConverter<TEnum> : JsonConverter<TEnum>
+    private sealed class EnumMemberJsonConverter<[DynamicallyAccessedMembers(DynamicallyAccessedMemberTypes.PublicFields)] TEnum> : JsonConverter<TEnum>
         where TEnum : struct, Enum
     {
         private static readonly IReadOnlyDictionary<TEnum, string> WriteMappings = BuildWriteMappings();
@@ -137,7 +141,7 @@ public sealed class EnumMemberJsonConverterFactory : JsonConverterFactory
                 values[pair.Key.ToString()] = pair.Key;
             }
 
-            foreach (var value in Enum.GetValues(typeof(TEnum)).Cast<TEnum>())
+            foreach (var value in Enum.GetValues<TEnum>())
             {
                 values[value.ToString()] = value;
             }
@@ -4,6 +4,7 @@ using System.Net.Http;
 using System.Net.Http.Headers;
 using System.Text;
 using System.Text.Json;
+using System.Text.Json.Serialization.Metadata;
 using System.Threading;
 using System.Threading.Tasks;
 
@@ -23,15 +24,26 @@ internal sealed class ApiClient
     {
         _httpClient = httpClient;
         _options = options;
-        _serializerOptions = new JsonSerializerOptions(JsonSerializerDefaults.Web)
+        _serializerOptions = CreateSerializerOptions();
+    }
+
+    internal static JsonSerializerOptions CreateSerializerOptions()
+    {
+        return new JsonSerializerOptions(JsonSerializerDefaults.Web)
         {
-            PropertyNameCaseInsensitive = true
+            PropertyNameCaseInsensitive = true,
+            TypeInfoResolver = JsonResolvers.WithReflectionFallback(SumUpJsonContext.Default)
         };
     }
 
+    internal JsonTypeInfo<T> GetTypeInfo<T>()
+    {
+        return (JsonTypeInfo<T>)_serializerOptions.GetTypeInfo(typeof(T));
+    }
+
     internal HttpRequestMessage CreateRequest(HttpMethod method, string pathTemplate, Action<RequestBuilder>? configure = null)
     {
-        var builder = new RequestBuilder(method, pathTemplate, _httpClient.BaseAddress ?? _options.BaseAddress);
+        var builder = new RequestBuilder(method, pathTemplate, _httpClient.BaseAddress ?? _options.BaseAddress, _options.OmitDefaultParameters);
         configure?.Invoke(builder);
         var request = builder.Build();
 
@@ -44,7 +56,9 @@ internal sealed class ApiClient
         return request;
     }
 
-    internal HttpContent CreateContent(object body, string? contentType)
+    // JSON bodies serialize with the metadata of their declared type, which the SDK's context has, so instances of
+    // caller-defined subclasses send the declared type's properties. Other body types use reflection when enabled.
+    internal HttpContent CreateContent<TBody>(TBody body, string? contentType)
     {
         if (body is HttpContent httpContent)
         {
@@ -56,6 +70,16 @@ internal sealed class ApiClient
             return new StringContent(text, Encoding.UTF8, contentType ?? "application/json");
         }
 
+        if (body is JsonMergePatch mergePatch)
+        {
+            return new StringContent(mergePatch.ToString(), Encoding.UTF8, contentType ?? "application/merge-patch+json");
+        }
+
+        if (body is JsonPatch jsonPatch)
+        {
+            return new StringContent(jsonPatch.ToString(), Encoding.UTF8, contentType ?? "application/json-patch+json");
+        }
+
         if (body is JsonDocument document)
         {
             return new StringContent(document.RootElement.GetRawText(), Encoding.UTF8, contentType ?? "application/json");
@@ -75,7 +99,7 @@ internal sealed class ApiClient
             return byteContent;
         }
 
-        var json = JsonSerializer.Serialize(body, _serializerOptions);
+        var json = JsonSerializer.Serialize(body, GetTypeInfo<TBody>());
         return new StringContent(json, Encoding.UTF8, contentType ?? "application/json");
     }
 
@@ -88,7 +112,7 @@ internal sealed class ApiClient
 
         try
         {
-            return JsonSerializer.Deserialize<TModel>(payload!, _serializerOptions);
+            return JsonSerializer.Deserialize(payload!, GetTypeInfo<TModel>());
         }
         catch (JsonException)
         {
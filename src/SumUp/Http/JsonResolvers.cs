@@ -0,0 +1,25 @@
+using System.Diagnostics.CodeAnalysis;
+using System.Text.Json;
+using System.Text.Json.Serialization.Metadata;
+
+namespace SumUp.Http;
+
+internal static class JsonResolvers
+{
+    private const string GuardJustification = "Reflection is only added when JsonSerializer.IsReflectionEnabledByDefault, a feature switch trimmed and NativeAOT applications turn off, so the branch is removed from them.";
+
+    // Types outside the source-generated context, such as caller POCOs in free-form objects or request bodies, keep
+    // serializing with reflection as they did before the SDK used source generation, unless the application has
+    // disabled reflection-based serialization.
+    [UnconditionalSuppressMessage("Trimming", "IL2026", Justification = GuardJustification)]
+    [UnconditionalSuppressMessage("AOT", "IL3050", Justification = GuardJustification)]
+    internal static IJsonTypeInfoResolver? WithReflectionFallback(IJsonTypeInfoResolver? resolver)
+    {
+        if (!JsonSerializer.IsReflectionEnabledByDefault)
+        {
+            return resolver;
+        }
+
+        return JsonTypeInfoResolver.Combine(resolver, new DefaultJsonTypeInfoResolver());
+    }
+}
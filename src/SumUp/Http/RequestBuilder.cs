@@ -1,30 +1,29 @@
 using System;
 using System.Collections;
 using System.Collections.Generic;
-using System.Collections.Concurrent;
 using System.Globalization;
-using System.Reflection;
-using System.Runtime.Serialization;
 using System.Net.Http;
 using System.Text;
+using System.Text.Json;
 
 namespace SumUp.Http;
 
 internal sealed class RequestBuilder
 {
-    private static readonly ConcurrentDictionary<Type, IReadOnlyDictionary<object, string>> EnumValueMappings = new();
     private readonly HttpMethod _method;
     private readonly string _pathTemplate;
     private readonly Uri _baseAddress;
     private readonly Dictionary<string, string> _pathParameters = new(StringComparer.OrdinalIgnoreCase);
     private readonly List<KeyValuePair<string, string>> _query = new();
     private readonly List<KeyValuePair<string, string>> _headers = new();
+    private readonly bool _omitDefaultParameters;
 
-    internal RequestBuilder(HttpMethod method, string pathTemplate, Uri baseAddress)
+    internal RequestBuilder(HttpMethod method, string pathTemplate, Uri baseAddress, bool omitDefaultParameters = false)
     {
         _method = method;
         _pathTemplate = pathTemplate;
         _baseAddress = baseAddress;
+        _omitDefaultParameters = omitDefaultParameters;
     }
 
     internal void AddPath(string name, object? value)
@@ -68,6 +67,16 @@ internal sealed class RequestBuilder
         _query.Add(new KeyValuePair<string, string>(name, ConvertToString(value)));
     }
 
+    internal void AddQuery(string name, object? value, object defaultValue)
+    {
+        if (_omitDefaultParameters && Equals(value, defaultValue))
+        {
+            return;
+        }
+
+        AddQuery(name, value);
+    }
+
     internal void AddQuery<T>(string name, OptionalQuery<T> value)
     {
         if (!value.IsSet)
@@ -109,6 +118,16 @@ internal sealed class RequestBuilder
         _headers.Add(new KeyValuePair<string, string>(name, ConvertToString(value)));
     }
 
+    internal void AddHeader(string name, object? value, object defaultValue)
+    {
+        if (_omitDefaultParameters && Equals(value, defaultValue))
+        {
+            return;
+        }
+
+        AddHeader(name, value);
+    }
+
     internal HttpRequestMessage Build()
     {
         var path = _pathTemplate;
@@ -147,7 +166,7 @@ internal sealed class RequestBuilder
         return request;
     }
 
-    private static string ConvertToString(object value)
+    internal static string ConvertToString(object value)
     {
         if (value is DateOnly dateOnly)
         {
@@ -178,29 +197,17 @@ internal sealed class RequestBuilder
         return Convert.ToString(value, CultureInfo.InvariantCulture) ?? string.Empty;
     }
 
+    // Generated enums carry their wire values in the converters of the SDK's JSON context, which needs no
+    // reflection; other enums format as their member name.
     private static string ConvertEnumToString(Type enumType, object value)
     {
-        var mappings = EnumValueMappings.GetOrAdd(enumType, static type =>
+        if (SumUpJsonContext.Default.GetTypeInfo(enumType) is { } typeInfo)
         {
-            var values = new Dictionary<object, string>();
-            foreach (var field in type.GetFields(BindingFlags.Public | BindingFlags.Static))
+            var element = JsonSerializer.SerializeToElement(value, typeInfo);
+            if (element.ValueKind == JsonValueKind.String)
             {
-                var enumValue = field.GetValue(null);
-                if (enumValue is null)
-                {
-                    continue;
-                }
-
-                var enumMember = field.GetCustomAttribute<EnumMemberAttribute>();
-                values[enumValue] = enumMember?.Value ?? field.Name;
+                return element.GetString()!;
             }
-
-            return values;
-        });
-
-        if (mappings.TryGetValue(value, out var mapped))
-        {
-            return mapped;
         }
 
         return Convert.ToString(value, CultureInfo.InvariantCulture) ?? string.Empty;
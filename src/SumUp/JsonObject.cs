@@ -1,7 +1,14 @@
 using System;
+using System.Buffers;
+using System.Collections;
 using System.Collections.Generic;
+using System.Diagnostics.CodeAnalysis;
+using System.Globalization;
+using System.Runtime.CompilerServices;
 using System.Text.Json;
 using System.Text.Json.Serialization;
+using System.Text.Json.Serialization.Metadata;
+using SumUp.Http;
 
 namespace SumUp;
 
@@ -11,6 +18,8 @@ namespace SumUp;
 [JsonConverter(typeof(JsonObjectConverter))]
 public class JsonObject : Dictionary<string, object?>
 {
+    private const string ReflectionMessage = "Converting a value to an arbitrary type uses reflection-based serialization. Use the overload taking a JsonTypeInfo<T> when trimming or compiling with NativeAOT.";
+
     /// <summary>
     /// Parse a JSON object string into a <see cref="JsonObject"/>.
     /// </summary>
@@ -21,12 +30,18 @@ public class JsonObject : Dictionary<string, object?>
             throw new ArgumentNullException(nameof(json));
         }
 
-        return JsonSerializer.Deserialize<JsonObject>(json, options) ?? new JsonObject();
+        // The reader settings of options apply; the values are read without serializer metadata.
+        var typeInfo = options is null
+            ? SumUpJsonContext.Default.JsonObject
+            : (JsonTypeInfo<JsonObject>)new JsonSerializerOptions(options) { TypeInfoResolver = SumUpJsonContext.Default }.GetTypeInfo(typeof(JsonObject));
+        return JsonSerializer.Deserialize(json, typeInfo) ?? new JsonObject();
     }
 
     /// <summary>
     /// Read a stored value and convert it to the requested type.
     /// </summary>
+    [RequiresUnreferencedCode(ReflectionMessage)]
+    [RequiresDynamicCode(ReflectionMessage)]
     public T? GetValue<T>(string propertyName, JsonSerializerOptions? options = null)
     {
         if (!TryGetValue(propertyName, out var value))
@@ -42,11 +57,49 @@ public class JsonObject : Dictionary<string, object?>
         var payload = JsonSerializer.SerializeToUtf8Bytes(value, options);
         return JsonSerializer.Deserialize<T>(payload, options);
     }
+
+    /// <summary>
+    /// Read a stored value and convert it to the type described by <paramref name="typeInfo"/>, without
+    /// reflection.
+    /// </summary>
+    public T? GetValue<T>(string propertyName, JsonTypeInfo<T> typeInfo)
+    {
+        if (typeInfo is null)
+        {
+            throw new ArgumentNullException(nameof(typeInfo));
+        }
+
+        if (!TryGetValue(propertyName, out var value))
+        {
+            return default;
+        }
+
+        if (value is T typedValue)
+        {
+            return typedValue;
+        }
+
+        var buffer = new ArrayBufferWriter<byte>();
+        using (var writer = new Utf8JsonWriter(buffer))
+        {
+            JsonObjectConverter.WriteValue(writer, value, typeInfo.Options);
+        }
+
+        return JsonSerializer.Deserialize(buffer.WrittenSpan, typeInfo);
+    }
 }
 
 internal sealed class JsonObjectConverter : JsonConverter<JsonObject>
 {
+    // Options extending those of a write with reflection, for the values their resolvers have no metadata for.
+    private static readonly ConditionalWeakTable<JsonSerializerOptions, JsonSerializerOptions> ReflectionOptions = new();
+
     public override JsonObject Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)
+    {
+        return ReadObject(ref reader, options);
+    }
+
+    private static JsonObject ReadObject(ref Utf8JsonReader reader, JsonSerializerOptions options)
     {
         if (reader.TokenType != JsonTokenType.StartObject)
         {
@@ -82,18 +135,169 @@ internal sealed class JsonObjectConverter : JsonConverter<JsonObject>
         foreach (var pair in value)
         {
             writer.WritePropertyName(pair.Key);
-            JsonSerializer.Serialize(writer, pair.Value, options);
+            WriteValue(writer, pair.Value, options);
         }
 
         writer.WriteEndObject();
     }
 
+    // Writes the values Read produces, and the common ones callers add, without serializer metadata so the
+    // SDK's source-generated context need not list every type a free-form object may hold. Other values use the
+    // metadata of the options, and reflection when it has none and the application allows it.
+    internal static void WriteValue(Utf8JsonWriter writer, object? value, JsonSerializerOptions options)
+    {
+        switch (value)
+        {
+            case null:
+                writer.WriteNullValue();
+                return;
+            case string text:
+                writer.WriteStringValue(text);
+                return;
+            case bool boolean:
+                writer.WriteBooleanValue(boolean);
+                return;
+            case int intValue:
+                writer.WriteNumberValue(intValue);
+                return;
+            case long longValue:
+                writer.WriteNumberValue(longValue);
+                return;
+            case short shortValue:
+                writer.WriteNumberValue(shortValue);
+                return;
+            case byte byteValue:
+                writer.WriteNumberValue(byteValue);
+                return;
+            case sbyte sbyteValue:
+                writer.WriteNumberValue(sbyteValue);
+                return;
+            case ushort ushortValue:
+                writer.WriteNumberValue(ushortValue);
+                return;
+            case uint uintValue:
+                writer.WriteNumberValue(uintValue);
+                return;
+            case ulong ulongValue:
+                writer.WriteNumberValue(ulongValue);
+                return;
+            case float floatValue:
+                writer.WriteNumberValue(floatValue);
+                return;
+            case double doubleValue:
+                writer.WriteNumberValue(doubleValue);
+                return;
+            case decimal decimalValue:
+                writer.WriteNumberValue(decimalValue);
+                return;
+            case Guid guid:
+                writer.WriteStringValue(guid);
+                return;
+            case DateTime dateTime:
+                writer.WriteStringValue(dateTime);
+                return;
+            case DateTimeOffset dateTimeOffset:
+                writer.WriteStringValue(dateTimeOffset);
+                return;
+            case byte[] bytes:
+                writer.WriteBase64StringValue(bytes);
+                return;
+            case Uri uri:
+                writer.WriteStringValue(uri.OriginalString);
+                return;
+            case JsonElement element:
+                element.WriteTo(writer);
+                return;
+            case System.Text.Json.Nodes.JsonNode node:
+                node.WriteTo(writer, options);
+                return;
+            case IEnumerable<KeyValuePair<string, object?>> pairs:
+                writer.WriteStartObject();
+                foreach (var pair in pairs)
+                {
+                    writer.WritePropertyName(pair.Key);
+                    WriteValue(writer, pair.Value, options);
+                }
+                writer.WriteEndObject();
+                return;
+            case IEnumerable<KeyValuePair<string, string?>> stringPairs:
+                writer.WriteStartObject();
+                foreach (var pair in stringPairs)
+                {
+                    writer.WritePropertyName(pair.Key);
+                    WriteValue(writer, pair.Value, options);
+                }
+                writer.WriteEndObject();
+                return;
+            case IDictionary entries:
+                writer.WriteStartObject();
+                foreach (DictionaryEntry entry in entries)
+                {
+                    writer.WritePropertyName(Convert.ToString(entry.Key, CultureInfo.InvariantCulture) ?? string.Empty);
+                    WriteValue(writer, entry.Value, options);
+                }
+                writer.WriteEndObject();
+                return;
+            case IEnumerable items when !IsStringKeyedDictionary(items.GetType()):
+                writer.WriteStartArray();
+                foreach (var item in items)
+                {
+                    WriteValue(writer, item, options);
+                }
+                writer.WriteEndArray();
+                return;
+            default:
+                if (options.TryGetTypeInfo(value.GetType(), out var typeInfo))
+                {
+                    JsonSerializer.Serialize(writer, value, typeInfo);
+                    return;
+                }
+
+                var fallback = ReflectionOptions.GetValue(options, CreateReflectionOptions);
+                if (fallback.TryGetTypeInfo(value.GetType(), out typeInfo))
+                {
+                    JsonSerializer.Serialize(writer, value, typeInfo);
+                    return;
+                }
+
+                throw new NotSupportedException(
+                    $"JsonObject cannot write a value of type '{value.GetType()}' as the serializer options have no metadata for it " +
+                    "and reflection-based serialization is disabled. Add the type to a JsonSerializerContext in the options, or store the value as a JsonElement.");
+        }
+    }
+
+    // Read-only dictionaries that are not IDictionary, such as IReadOnlyDictionary<string, int>, serialize as objects
+    // and so take the fallback rather than being written as arrays of pairs.
+    [UnconditionalSuppressMessage("Trimming", "IL2070", Justification = "The interfaces of a type are kept with the instances implementing them.")]
+    private static bool IsStringKeyedDictionary(Type type)
+    {
+        foreach (var contract in type.GetInterfaces())
+        {
+            if (contract.IsGenericType
+                && contract.GetGenericTypeDefinition() == typeof(IReadOnlyDictionary<,>)
+                && contract.GetGenericArguments()[0] == typeof(string))
+            {
+                return true;
+            }
+        }
+
+        return false;
+    }
+
+    private static JsonSerializerOptions CreateReflectionOptions(JsonSerializerOptions options)
+    {
+        return new JsonSerializerOptions(options)
+        {
+            TypeInfoResolver = JsonResolvers.WithReflectionFallback(options.TypeInfoResolver),
+        };
+    }
+
     private static object? ReadValue(ref Utf8JsonReader reader, JsonSerializerOptions options)
     {
         switch (reader.TokenType)
         {
             case JsonTokenType.StartObject:
-                return JsonSerializer.Deserialize<JsonObject>(ref reader, options);
+                return ReadObject(ref reader, options);
             case JsonTokenType.StartArray:
                 return ReadArray(ref reader, options);
             case JsonTokenType.String:
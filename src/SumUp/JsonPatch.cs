@@ -0,0 +1,217 @@
+using System;
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Text.Json.Nodes;
+using System.Text.Json.Serialization;
+using SumUp.Http;
+using JsonNodeObject = System.Text.Json.Nodes.JsonObject;
+
+namespace SumUp;
+
+/// <summary>
+/// Base class of the generated JSON Merge Patch (RFC 7396) builders, which hold the fields that differ between
+/// two copies of a resource.
+/// </summary>
+public abstract class JsonMergePatch
+{
+    private readonly JsonNodeObject _changes;
+
+    protected JsonMergePatch(JsonNodeObject changes)
+    {
+        _changes = changes ?? throw new ArgumentNullException(nameof(changes));
+    }
+
+    /// <summary>
+    /// Indicates whether the copies are equal, so the patch changes nothing.
+    /// </summary>
+    public bool IsEmpty => _changes.Count == 0;
+
+    /// <summary>
+    /// Returns the merge patch document.
+    /// </summary>
+    public override string ToString() => _changes.ToJsonString();
+
+    /// <summary>
+    /// Computes the merge patch turning <paramref name="original"/> into <paramref name="modified"/>: changed
+    /// fields with their new value, removed fields as null, and nested objects as patches of their own.
+    /// </summary>
+    protected static JsonNodeObject Diff<T>(T original, T modified)
+    {
+        return Diff(JsonPatchSerializer.ToObject(original), JsonPatchSerializer.ToObject(modified));
+    }
+
+    private static JsonNodeObject Diff(JsonNodeObject original, JsonNodeObject modified)
+    {
+        var changes = new JsonNodeObject();
+        foreach (var (name, _) in original)
+        {
+            if (!modified.ContainsKey(name))
+            {
+                changes[name] = null;
+            }
+        }
+
+        foreach (var (name, value) in modified)
+        {
+            var exists = original.TryGetPropertyValue(name, out var previous);
+            if (exists ? JsonNode.DeepEquals(previous, value) : value is null)
+            {
+                continue;
+            }
+
+            changes[name] = previous is JsonNodeObject previousObject && value is JsonNodeObject valueObject
+                ? Diff(previousObject, valueObject)
+                : value?.DeepClone();
+        }
+
+        return changes;
+    }
+}
+
+/// <summary>
+/// Base class of the generated JSON Patch (RFC 6902) builders, which hold the operations turning one copy of a
+/// resource into another.
+/// </summary>
+public abstract class JsonPatch
+{
+    protected JsonPatch(IReadOnlyList<JsonPatchOperation> operations)
+    {
+        Operations = operations ?? throw new ArgumentNullException(nameof(operations));
+    }
+
+    /// <summary>
+    /// The operations of the patch, in the order they apply.
+    /// </summary>
+    public IReadOnlyList<JsonPatchOperation> Operations { get; }
+
+    /// <summary>
+    /// Indicates whether the copies are equal, so the patch changes nothing.
+    /// </summary>
+    public bool IsEmpty => Operations.Count == 0;
+
+    /// <summary>
+    /// Returns the JSON Patch document.
+    /// </summary>
+    public override string ToString() =>
+        JsonSerializer.Serialize(Operations, JsonPatchSerializer.Options.GetTypeInfo(typeof(IReadOnlyList<JsonPatchOperation>)));
+
+    /// <summary>
+    /// Computes the operations turning <paramref name="original"/> into <paramref name="modified"/>: <c>add</c>
+    /// and <c>remove</c> for added and removed fields, and <c>replace</c> for changed values. Arrays are replaced
+    /// as a whole.
+    /// </summary>
+    protected static IReadOnlyList<JsonPatchOperation> Diff<T>(T original, T modified)
+    {
+        var operations = new List<JsonPatchOperation>();
+        Diff(string.Empty, JsonPatchSerializer.ToObject(original), JsonPatchSerializer.ToObject(modified), operations);
+        return operations;
+    }
+
+    private static void Diff(string path, JsonNode? original, JsonNode? modified, List<JsonPatchOperation> operations)
+    {
+        if (JsonNode.DeepEquals(original, modified))
+        {
+            return;
+        }
+
+        if (original is not JsonNodeObject originalObject || modified is not JsonNodeObject modifiedObject)
+        {
+            operations.Add(new JsonPatchOperation("replace", path, modified?.DeepClone()));
+            return;
+        }
+
+        foreach (var (name, _) in originalObject)
+        {
+            if (!modifiedObject.ContainsKey(name))
+            {
+                operations.Add(new JsonPatchOperation("remove", Append(path, name), null));
+            }
+        }
+
+        foreach (var (name, value) in modifiedObject)
+        {
+            if (originalObject.TryGetPropertyValue(name, out var previous))
+            {
+                Diff(Append(path, name), previous, value, operations);
+            }
+            else
+            {
+                operations.Add(new JsonPatchOperation("add", Append(path, name), value?.DeepClone()));
+            }
+        }
+    }
+
+    private static string Append(string path, string name) =>
+        path + "/" + name.Replace("~", "~0").Replace("/", "~1");
+}
+
+/// <summary>
+/// An operation of a <see cref="JsonPatch"/>.
+/// </summary>
+[JsonConverter(typeof(JsonPatchOperationConverter))]
+public sealed class JsonPatchOperation
+{
+    public JsonPatchOperation(string op, string path, JsonNode? value)
+    {
+        Op = op;
+        Path = path;
+        Value = value;
+    }
+
+    /// <summary>
+    /// The operation: <c>add</c>, <c>remove</c> or <c>replace</c>.
+    /// </summary>
+    public string Op { get; }
+
+    /// <summary>
+    /// JSON Pointer of the target field.
+    /// </summary>
+    public string Path { get; }
+
+    /// <summary>
+    /// The new value of <c>add</c> and <c>replace</c> operations, which may be null.
+    /// </summary>
+    public JsonNode? Value { get; }
+}
+
+internal sealed class JsonPatchOperationConverter : JsonConverter<JsonPatchOperation>
+{
+    public override JsonPatchOperation Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)
+    {
+        throw new NotSupportedException("JSON Patch operations are only serialized.");
+    }
+
+    public override void Write(Utf8JsonWriter writer, JsonPatchOperation value, JsonSerializerOptions options)
+    {
+        writer.WriteStartObject();
+        writer.WriteString("op", value.Op);
+        writer.WriteString("path", value.Path);
+        if (value.Op != "remove")
+        {
+            writer.WritePropertyName("value");
+            if (value.Value is null)
+            {
+                writer.WriteNullValue();
+            }
+            else
+            {
+                value.Value.WriteTo(writer, options);
+            }
+        }
+
+        writer.WriteEndObject();
+    }
+}
+
+internal static class JsonPatchSerializer
+{
+    internal static readonly JsonSerializerOptions Options = ApiClient.CreateSerializerOptions();
+
+    // Models serialize unset properties as null, so both copies list the same
+    // fields and only the values set differently are reported.
+    internal static JsonNodeObject ToObject<T>(T value)
+    {
+        return JsonSerializer.SerializeToNode(value, Options.GetTypeInfo(typeof(T))) as JsonNodeObject
+            ?? throw new ArgumentException("Patches can only be computed between JSON objects.", nameof(value));
+    }
+}
@@ -79,7 +79,7 @@ public sealed partial class MembersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Member>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Member>());
             return ApiResponse<Member>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -143,7 +143,7 @@ public sealed partial class MembersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Member>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Member>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Member>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -302,7 +302,7 @@ public sealed partial class MembersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Member>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Member>());
             return ApiResponse<Member>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -353,7 +353,7 @@ public sealed partial class MembersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Member>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Member>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Member>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -376,9 +376,9 @@ public sealed partial class MembersClient
         var request = _client.CreateRequest(HttpMethod.Get, "/v0.1/merchants/{merchant_code}/members", builder =>
         {
             builder.AddPath("merchant_code", merchantCode);
-            builder.AddQuery("offset", operationOptions.Offset);
-            builder.AddQuery("limit", operationOptions.Limit);
-            builder.AddQuery("scroll", operationOptions.Scroll);
+            builder.AddQuery("offset", operationOptions.Offset, 0);
+            builder.AddQuery("limit", operationOptions.Limit, 10);
+            builder.AddQuery("scroll", operationOptions.Scroll, false);
             builder.AddQuery("email", operationOptions.Email);
             builder.AddQuery("user.id", operationOptions.UserId);
             builder.AddQuery("status", operationOptions.Status);
@@ -411,7 +411,7 @@ public sealed partial class MembersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<MembersListResponse>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<MembersListResponse>());
             return ApiResponse<MembersListResponse>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -434,9 +434,9 @@ public sealed partial class MembersClient
         var request = _client.CreateRequest(HttpMethod.Get, "/v0.1/merchants/{merchant_code}/members", builder =>
         {
             builder.AddPath("merchant_code", merchantCode);
-            builder.AddQuery("offset", operationOptions.Offset);
-            builder.AddQuery("limit", operationOptions.Limit);
-            builder.AddQuery("scroll", operationOptions.Scroll);
+            builder.AddQuery("offset", operationOptions.Offset, 0);
+            builder.AddQuery("limit", operationOptions.Limit, 10);
+            builder.AddQuery("scroll", operationOptions.Scroll, false);
             builder.AddQuery("email", operationOptions.Email);
             builder.AddQuery("user.id", operationOptions.UserId);
             builder.AddQuery("status", operationOptions.Status);
@@ -469,7 +469,7 @@ public sealed partial class MembersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<MembersListResponse>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<MembersListResponse>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<MembersListResponse>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -540,7 +540,7 @@ public sealed partial class MembersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Member>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Member>());
             return ApiResponse<Member>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -611,7 +611,7 @@ public sealed partial class MembersClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Member>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Member>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Member>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -36,8 +36,8 @@ public sealed partial class MembershipsClient
         var operationOptions = options ?? new MembershipsListOptions();
         var request = _client.CreateRequest(HttpMethod.Get, "/v0.1/memberships", builder =>
         {
-            builder.AddQuery("offset", operationOptions.Offset);
-            builder.AddQuery("limit", operationOptions.Limit);
+            builder.AddQuery("offset", operationOptions.Offset, 0);
+            builder.AddQuery("limit", operationOptions.Limit, 10);
             builder.AddQuery("kind", operationOptions.Kind);
             builder.AddQuery("status", operationOptions.Status);
             builder.AddQuery("resource.type", operationOptions.ResourceType);
@@ -79,7 +79,7 @@ public sealed partial class MembershipsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<MembershipsListResponse>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<MembershipsListResponse>());
             return ApiResponse<MembershipsListResponse>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -100,8 +100,8 @@ public sealed partial class MembershipsClient
         var operationOptions = options ?? new MembershipsListOptions();
         var request = _client.CreateRequest(HttpMethod.Get, "/v0.1/memberships", builder =>
         {
-            builder.AddQuery("offset", operationOptions.Offset);
-            builder.AddQuery("limit", operationOptions.Limit);
+            builder.AddQuery("offset", operationOptions.Offset, 0);
+            builder.AddQuery("limit", operationOptions.Limit, 10);
             builder.AddQuery("kind", operationOptions.Kind);
             builder.AddQuery("status", operationOptions.Status);
             builder.AddQuery("resource.type", operationOptions.ResourceType);
@@ -143,7 +143,7 @@ public sealed partial class MembershipsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<MembershipsListResponse>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<MembershipsListResponse>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<MembershipsListResponse>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
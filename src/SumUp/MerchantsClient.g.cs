@@ -66,7 +66,7 @@ public sealed partial class MerchantsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Merchant>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Merchant>());
             return ApiResponse<Merchant>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -118,7 +118,7 @@ public sealed partial class MerchantsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Merchant>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Merchant>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Merchant>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -172,7 +172,7 @@ public sealed partial class MerchantsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<Person>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<Person>());
             return ApiResponse<Person>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -226,7 +226,7 @@ public sealed partial class MerchantsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<Person>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<Person>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<Person>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -278,7 +278,7 @@ public sealed partial class MerchantsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<ListPersonsResponseBody>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<ListPersonsResponseBody>());
             return ApiResponse<ListPersonsResponseBody>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -330,7 +330,7 @@ public sealed partial class MerchantsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<ListPersonsResponseBody>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<ListPersonsResponseBody>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<ListPersonsResponseBody>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(BadRequestErrorsTypeJsonConverter))]
 public enum BadRequestErrorsType
 {
     [EnumMember(Value = "INVALID_BEARER_TOKEN")]
@@ -17,4 +18,18 @@ public enum BadRequestErrorsType
     NotEnoughUnpaidPayouts,
     [EnumMember(Value = "DUPLICATE_HEADERS")]
     DuplicateHeaders,
+}
+
+internal sealed class BadRequestErrorsTypeJsonConverter : EnumJsonConverter<BadRequestErrorsType>
+{
+    public BadRequestErrorsTypeJsonConverter()
+        : base(new Dictionary<BadRequestErrorsType, string>
+        {
+            [BadRequestErrorsType.InvalidBearerToken] = "INVALID_BEARER_TOKEN",
+            [BadRequestErrorsType.InvalidUserAgent] = "INVALID_USER_AGENT",
+            [BadRequestErrorsType.NotEnoughUnpaidPayouts] = "NOT_ENOUGH_UNPAID_PAYOUTS",
+            [BadRequestErrorsType.DuplicateHeaders] = "DUPLICATE_HEADERS",
+        })
+    {
+    }
 }
\ No newline at end of file
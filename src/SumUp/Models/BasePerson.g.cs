@@ -6,7 +6,7 @@ namespace SumUp;
 using System.Text.Json.Serialization;
 using System.Collections.Generic;
 /// <summary>Base schema for a Person associated with a Merchant. This can be a legal representative, business owner (ultimate beneficial owner), or an officer. A legal representative is the Person who registered the Merchant with SumUp. They should always have a user_id.</summary>
-public sealed partial class BasePerson
+public partial class BasePerson
 {
     /// <summary>An address somewhere in the world. The address fields used depend on the country conventions. For example, in Great Britain, city is post_town. In the United States, the top-level administrative unit used in addresses is state, whereas in Chile it's region. Whether an address is valid or not depends on whether the locally required fields are present. Fields not supported in a country will be ignored.</summary>
     [JsonPropertyName("address")]
@@ -17,7 +17,7 @@ public sealed partial class BasePerson
     /// <summary>Reflects the status of changes submitted through the PATCH endpoints for the Merchant or Persons. If some changes have not been applied yet, the status will be pending. If all changes have been applied, the status done. The status is only returned after write operations or on read endpoints when the version query parameter is provided.</summary>
     [JsonPropertyName("change_status")]
     [JsonInclude]
-    public string? ChangeStatus { get; private set; }
+    public string? ChangeStatus { get; internal set; }
     /// <summary>An ISO3166-1 alpha-2 country code. This definition users oneOf with a two-character string type to allow for support of future countries in client code.</summary>
     [JsonPropertyName("citizenship")]
     public string? Citizenship { get; set; }
@@ -33,7 +33,7 @@ public sealed partial class BasePerson
     /// <summary>The unique identifier for the Person. This is a typeid.</summary>
     [JsonPropertyName("id")]
     [JsonInclude]
-    public string Id { get; private set; } = default!;
+    public string Id { get; internal set; } = default!;
     /// <summary>A list of country-specific personal identifiers.</summary>
     [JsonPropertyName("identifiers")]
     public IEnumerable<PersonalIdentifier>? Identifiers { get; set; }
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(CardTypeJsonConverter))]
 public enum CardType
 {
     [EnumMember(Value = "ALELO")]
@@ -57,4 +58,38 @@ public enum CardType
     Vr,
     [EnumMember(Value = "UNKNOWN")]
     Unknown,
+}
+
+internal sealed class CardTypeJsonConverter : EnumJsonConverter<CardType>
+{
+    public CardTypeJsonConverter()
+        : base(new Dictionary<CardType, string>
+        {
+            [CardType.Alelo] = "ALELO",
+            [CardType.Amex] = "AMEX",
+            [CardType.Conecs] = "CONECS",
+            [CardType.Cup] = "CUP",
+            [CardType.Diners] = "DINERS",
+            [CardType.Discover] = "DISCOVER",
+            [CardType.Eftpos] = "EFTPOS",
+            [CardType.Elo] = "ELO",
+            [CardType.Elv] = "ELV",
+            [CardType.Girocard] = "GIROCARD",
+            [CardType.Hipercard] = "HIPERCARD",
+            [CardType.Interac] = "INTERAC",
+            [CardType.Jcb] = "JCB",
+            [CardType.Maestro] = "MAESTRO",
+            [CardType.Mastercard] = "MASTERCARD",
+            [CardType.Pluxee] = "PLUXEE",
+            [CardType.Swile] = "SWILE",
+            [CardType.Ticket] = "TICKET",
+            [CardType.Visa] = "VISA",
+            [CardType.VisaElectron] = "VISA_ELECTRON",
+            [CardType.VisaVpay] = "VISA_VPAY",
+            [CardType.Vpay] = "VPAY",
+            [CardType.Vr] = "VR",
+            [CardType.Unknown] = "UNKNOWN",
+        })
+    {
+    }
 }
\ No newline at end of file
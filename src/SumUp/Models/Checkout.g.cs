@@ -6,7 +6,7 @@ namespace SumUp;
 using System.Text.Json.Serialization;
 using System.Collections.Generic;
 /// <summary>Core checkout resource returned by the Checkouts API. A checkout is created before payment processing and then updated as payment attempts, redirects, and resulting transactions are attached to it.</summary>
-public sealed partial class Checkout
+public partial class Checkout
 {
     /// <summary>Amount to be charged to the payer, expressed in major units.</summary>
     [JsonPropertyName("amount")]
@@ -29,11 +29,11 @@ public sealed partial class Checkout
     /// <summary>URL of the SumUp-hosted payment page that handles the payment flow. Returned when Hosted Checkout is enabled for the checkout.</summary>
     [JsonPropertyName("hosted_checkout_url")]
     [JsonInclude]
-    public string? HostedCheckoutUrl { get; private set; }
+    public string? HostedCheckoutUrl { get; internal set; }
     /// <summary>Unique SumUp identifier of the checkout resource.</summary>
     [JsonPropertyName("id")]
     [JsonInclude]
-    public string? Id { get; private set; }
+    public string? Id { get; internal set; }
     /// <summary>Details of the mandate linked to the saved payment instrument.</summary>
     [JsonPropertyName("mandate")]
     public MandateResponse? Mandate { get; set; }
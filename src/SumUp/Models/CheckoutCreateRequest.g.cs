@@ -28,7 +28,7 @@ public sealed partial class CheckoutCreateRequest
     /// <summary>Short unique identifier for the merchant that should receive the payment.</summary>
     [JsonPropertyName("merchant_code")]
     public string MerchantCode { get; set; } = default!;
-    /// <summary>Business purpose of the checkout. Use CHECKOUT for a standard payment and SETUP_RECURRING_PAYMENT when collecting consent and payment details for future recurring charges.</summary>
+    /// <summary>Business purpose of the checkout. Use CHECKOUT for a standard payment and SETUP_RECURRING_PAYMENT when collecting consent and payment details for future recurring charges. Defaults to <c>CHECKOUT</c>.</summary>
     [JsonPropertyName("purpose")]
     public CheckoutCreateRequestPurpose? Purpose { get; set; }
     /// <summary>URL where the payer should be sent after a redirect-based payment or SCA flow completes. This is required for APMs and recommended for card checkouts that may require 3DS. If it is omitted, the Payment Widget can render the challenge in an iframe instead of using a full-page redirect.</summary>
@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(CheckoutCreateRequestPurposeJsonConverter))]
 public enum CheckoutCreateRequestPurpose
 {
     [EnumMember(Value = "CHECKOUT")]
     Checkout,
     [EnumMember(Value = "SETUP_RECURRING_PAYMENT")]
     SetupRecurringPayment,
+}
+
+internal sealed class CheckoutCreateRequestPurposeJsonConverter : EnumJsonConverter<CheckoutCreateRequestPurpose>
+{
+    public CheckoutCreateRequestPurposeJsonConverter()
+        : base(new Dictionary<CheckoutCreateRequestPurpose, string>
+        {
+            [CheckoutCreateRequestPurpose.Checkout] = "CHECKOUT",
+            [CheckoutCreateRequestPurpose.SetupRecurringPayment] = "SETUP_RECURRING_PAYMENT",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(CheckoutStatusJsonConverter))]
 public enum CheckoutStatus
 {
     [EnumMember(Value = "PENDING")]
@@ -17,4 +18,18 @@ public enum CheckoutStatus
     Paid,
     [EnumMember(Value = "EXPIRED")]
     Expired,
+}
+
+internal sealed class CheckoutStatusJsonConverter : EnumJsonConverter<CheckoutStatus>
+{
+    public CheckoutStatusJsonConverter()
+        : base(new Dictionary<CheckoutStatus, string>
+        {
+            [CheckoutStatus.Pending] = "PENDING",
+            [CheckoutStatus.Failed] = "FAILED",
+            [CheckoutStatus.Paid] = "PAID",
+            [CheckoutStatus.Expired] = "EXPIRED",
+        })
+    {
+    }
 }
\ No newline at end of file
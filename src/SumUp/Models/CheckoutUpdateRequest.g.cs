@@ -9,20 +9,32 @@ public sealed partial class CheckoutUpdateRequest
 {
     /// <summary>Updated amount to be charged to the payer, expressed in major units.</summary>
     [JsonPropertyName("amount")]
-    public float? Amount { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<float?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<float?> Amount { get; set; }
     /// <summary>Updated merchant-defined reference for the checkout.</summary>
     [JsonPropertyName("checkout_reference")]
-    public string? CheckoutReference { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<string?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<string?> CheckoutReference { get; set; }
     /// <summary>Three-letter ISO 4217 currency code of the amount.</summary>
     [JsonPropertyName("currency")]
-    public Currency? Currency { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<Currency?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<Currency?> Currency { get; set; }
     /// <summary>Updated merchant-scoped customer identifier associated with the checkout.</summary>
     [JsonPropertyName("customer_id")]
-    public string? CustomerId { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<string?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<string?> CustomerId { get; set; }
     /// <summary>Updated short merchant-defined description shown in SumUp tools and reporting.</summary>
     [JsonPropertyName("description")]
-    public string? Description { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<string?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<string?> Description { get; set; }
     /// <summary>Updated expiration timestamp. The checkout must be processed before this moment, otherwise it becomes unusable.</summary>
     [JsonPropertyName("valid_until")]
-    public DateTimeOffset? ValidUntil { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<DateTimeOffset?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<DateTimeOffset?> ValidUntil { get; set; }
 }
\ No newline at end of file
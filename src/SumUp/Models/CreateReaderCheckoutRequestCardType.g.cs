@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(CreateReaderCheckoutRequestCardTypeJsonConverter))]
 public enum CreateReaderCheckoutRequestCardType
 {
     [EnumMember(Value = "credit")]
     Credit,
     [EnumMember(Value = "debit")]
     Debit,
+}
+
+internal sealed class CreateReaderCheckoutRequestCardTypeJsonConverter : EnumJsonConverter<CreateReaderCheckoutRequestCardType>
+{
+    public CreateReaderCheckoutRequestCardTypeJsonConverter()
+        : base(new Dictionary<CreateReaderCheckoutRequestCardType, string>
+        {
+            [CreateReaderCheckoutRequestCardType.Credit] = "credit",
+            [CreateReaderCheckoutRequestCardType.Debit] = "debit",
+        })
+    {
+    }
 }
\ No newline at end of file
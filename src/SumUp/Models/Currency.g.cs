@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(CurrencyJsonConverter))]
 public enum Currency
 {
     [EnumMember(Value = "BGN")]
@@ -41,4 +42,30 @@ public enum Currency
     Sek,
     [EnumMember(Value = "USD")]
     Usd,
+}
+
+internal sealed class CurrencyJsonConverter : EnumJsonConverter<Currency>
+{
+    public CurrencyJsonConverter()
+        : base(new Dictionary<Currency, string>
+        {
+            [Currency.Bgn] = "BGN",
+            [Currency.Brl] = "BRL",
+            [Currency.Chf] = "CHF",
+            [Currency.Clp] = "CLP",
+            [Currency.Cop] = "COP",
+            [Currency.Czk] = "CZK",
+            [Currency.Dkk] = "DKK",
+            [Currency.Eur] = "EUR",
+            [Currency.Gbp] = "GBP",
+            [Currency.Hrk] = "HRK",
+            [Currency.Huf] = "HUF",
+            [Currency.Nok] = "NOK",
+            [Currency.Pln] = "PLN",
+            [Currency.Ron] = "RON",
+            [Currency.Sek] = "SEK",
+            [Currency.Usd] = "USD",
+        })
+    {
+    }
 }
\ No newline at end of file
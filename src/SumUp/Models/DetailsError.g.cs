@@ -76,6 +76,6 @@ public sealed partial class DetailsError
         {
             return builder.ToString();
         }
-        return JsonSerializer.Serialize(this);
+        return JsonSerializer.Serialize(this, typeof(DetailsError), SumUpJsonContext.Default);
     }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(EntryModeJsonConverter))]
 public enum EntryMode
 {
     [EnumMember(Value = "BOLETO")]
@@ -63,4 +64,41 @@ public enum EntryMode
     ContactlessMagstripe,
     [EnumMember(Value = "N/A")]
     NA,
+}
+
+internal sealed class EntryModeJsonConverter : EnumJsonConverter<EntryMode>
+{
+    public EntryModeJsonConverter()
+        : base(new Dictionary<EntryMode, string>
+        {
+            [EntryMode.Boleto] = "BOLETO",
+            [EntryMode.Sofort] = "SOFORT",
+            [EntryMode.Ideal] = "IDEAL",
+            [EntryMode.Bancontact] = "BANCONTACT",
+            [EntryMode.Eps] = "EPS",
+            [EntryMode.Mybank] = "MYBANK",
+            [EntryMode.Satispay] = "SATISPAY",
+            [EntryMode.Blik] = "BLIK",
+            [EntryMode.P24] = "P24",
+            [EntryMode.Giropay] = "GIROPAY",
+            [EntryMode.Pix] = "PIX",
+            [EntryMode.QrCodePix] = "QR_CODE_PIX",
+            [EntryMode.ApplePay] = "APPLE_PAY",
+            [EntryMode.GooglePay] = "GOOGLE_PAY",
+            [EntryMode.Paypal] = "PAYPAL",
+            [EntryMode.Twint] = "TWINT",
+            [EntryMode.None] = "NONE",
+            [EntryMode.Chip] = "CHIP",
+            [EntryMode.ManualEntry] = "MANUAL_ENTRY",
+            [EntryMode.CustomerEntry] = "CUSTOMER_ENTRY",
+            [EntryMode.MagstripeFallback] = "MAGSTRIPE_FALLBACK",
+            [EntryMode.Magstripe] = "MAGSTRIPE",
+            [EntryMode.DirectDebit] = "DIRECT_DEBIT",
+            [EntryMode.Contactless] = "CONTACTLESS",
+            [EntryMode.Moto] = "MOTO",
+            [EntryMode.ContactlessMagstripe] = "CONTACTLESS_MAGSTRIPE",
+            [EntryMode.NA] = "N/A",
+        })
+    {
+    }
 }
\ No newline at end of file
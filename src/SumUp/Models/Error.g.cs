@@ -7,7 +7,7 @@ using System.Text.Json.Serialization;
 using System.Text.Json;
 using System.Text;
 /// <summary>Details of an API error.</summary>
-public sealed partial class Error
+public partial class Error
 {
     /// <summary>Platform code for the error.</summary>
     [JsonPropertyName("error_code")]
@@ -61,6 +61,6 @@ public sealed partial class Error
         {
             return builder.ToString();
         }
-        return JsonSerializer.Serialize(this);
+        return JsonSerializer.Serialize(this, typeof(Error), SumUpJsonContext.Default);
     }
 }
\ No newline at end of file
@@ -7,14 +7,8 @@ using System.Text.Json.Serialization;
 using System.Text.Json;
 using System.Text;
 /// <summary>Error payload with the invalid parameter reference.</summary>
-public sealed partial class ErrorExtended
+public sealed partial class ErrorExtended : Error
 {
-    /// <summary>Platform code for the error.</summary>
-    [JsonPropertyName("error_code")]
-    public string? ErrorCode { get; set; }
-    /// <summary>Short description of the error.</summary>
-    [JsonPropertyName("message")]
-    public string? Message { get; set; }
     /// <summary>Parameter name (with relative location) to which the error applies. Parameters from embedded resources are displayed using dot notation. For example, card.name refers to the name parameter embedded in the card object.</summary>
     [JsonPropertyName("param")]
     public string? Param { get; set; }
@@ -68,6 +62,6 @@ public sealed partial class ErrorExtended
         {
             return builder.ToString();
         }
-        return JsonSerializer.Serialize(this);
+        return JsonSerializer.Serialize(this, typeof(ErrorExtended), SumUpJsonContext.Default);
     }
 }
\ No newline at end of file
@@ -68,6 +68,6 @@ public sealed partial class ErrorForbidden
         {
             return builder.ToString();
         }
-        return JsonSerializer.Serialize(this);
+        return JsonSerializer.Serialize(this, typeof(ErrorForbidden), SumUpJsonContext.Default);
     }
 }
\ No newline at end of file
@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(FinancialPayoutStatusJsonConverter))]
 public enum FinancialPayoutStatus
 {
     [EnumMember(Value = "SUCCESSFUL")]
     Successful,
     [EnumMember(Value = "FAILED")]
     Failed,
+}
+
+internal sealed class FinancialPayoutStatusJsonConverter : EnumJsonConverter<FinancialPayoutStatus>
+{
+    public FinancialPayoutStatusJsonConverter()
+        : base(new Dictionary<FinancialPayoutStatus, string>
+        {
+            [FinancialPayoutStatus.Successful] = "SUCCESSFUL",
+            [FinancialPayoutStatus.Failed] = "FAILED",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(FinancialPayoutTypeJsonConverter))]
 public enum FinancialPayoutType
 {
     [EnumMember(Value = "PAYOUT")]
@@ -19,4 +20,19 @@ public enum FinancialPayoutType
     DdReturnDeduction,
     [EnumMember(Value = "BALANCE_DEDUCTION")]
     BalanceDeduction,
+}
+
+internal sealed class FinancialPayoutTypeJsonConverter : EnumJsonConverter<FinancialPayoutType>
+{
+    public FinancialPayoutTypeJsonConverter()
+        : base(new Dictionary<FinancialPayoutType, string>
+        {
+            [FinancialPayoutType.Payout] = "PAYOUT",
+            [FinancialPayoutType.ChargeBackDeduction] = "CHARGE_BACK_DEDUCTION",
+            [FinancialPayoutType.RefundDeduction] = "REFUND_DEDUCTION",
+            [FinancialPayoutType.DdReturnDeduction] = "DD_RETURN_DEDUCTION",
+            [FinancialPayoutType.BalanceDeduction] = "BALANCE_DEDUCTION",
+        })
+    {
+    }
 }
\ No newline at end of file
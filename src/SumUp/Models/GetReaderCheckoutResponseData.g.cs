@@ -8,7 +8,7 @@ public sealed partial class GetReaderCheckoutResponseData
 {
     /// <summary>Type of the card. Required for some countries</summary>
     [JsonPropertyName("card_type")]
-    public GetReaderCheckoutResponseDataCardType CardType { get; set; }
+    public GetReaderCheckoutResponseDataCardType? CardType { get; set; }
     /// <summary>Unique identifier for the checkout</summary>
     [JsonPropertyName("checkout_id")]
     public Guid CheckoutId { get; set; }
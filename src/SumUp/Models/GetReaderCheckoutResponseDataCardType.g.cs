@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(GetReaderCheckoutResponseDataCardTypeJsonConverter))]
 public enum GetReaderCheckoutResponseDataCardType
 {
     [EnumMember(Value = "credit")]
     Credit,
     [EnumMember(Value = "debit")]
     Debit,
+}
+
+internal sealed class GetReaderCheckoutResponseDataCardTypeJsonConverter : EnumJsonConverter<GetReaderCheckoutResponseDataCardType>
+{
+    public GetReaderCheckoutResponseDataCardTypeJsonConverter()
+        : base(new Dictionary<GetReaderCheckoutResponseDataCardType, string>
+        {
+            [GetReaderCheckoutResponseDataCardType.Credit] = "credit",
+            [GetReaderCheckoutResponseDataCardType.Debit] = "debit",
+        })
+    {
+    }
 }
\ No newline at end of file
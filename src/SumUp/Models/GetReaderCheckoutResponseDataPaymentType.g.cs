@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(GetReaderCheckoutResponseDataPaymentTypeJsonConverter))]
 public enum GetReaderCheckoutResponseDataPaymentType
 {
     [EnumMember(Value = "card")]
     Card,
     [EnumMember(Value = "pix")]
     Pix,
+}
+
+internal sealed class GetReaderCheckoutResponseDataPaymentTypeJsonConverter : EnumJsonConverter<GetReaderCheckoutResponseDataPaymentType>
+{
+    public GetReaderCheckoutResponseDataPaymentTypeJsonConverter()
+        : base(new Dictionary<GetReaderCheckoutResponseDataPaymentType, string>
+        {
+            [GetReaderCheckoutResponseDataPaymentType.Card] = "card",
+            [GetReaderCheckoutResponseDataPaymentType.Pix] = "pix",
+        })
+    {
+    }
 }
\ No newline at end of file
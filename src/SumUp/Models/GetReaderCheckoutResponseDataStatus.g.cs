@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(GetReaderCheckoutResponseDataStatusJsonConverter))]
 public enum GetReaderCheckoutResponseDataStatus
 {
     [EnumMember(Value = "pending")]
@@ -17,4 +18,18 @@ public enum GetReaderCheckoutResponseDataStatus
     Failed,
     [EnumMember(Value = "cancelled")]
     Cancelled,
+}
+
+internal sealed class GetReaderCheckoutResponseDataStatusJsonConverter : EnumJsonConverter<GetReaderCheckoutResponseDataStatus>
+{
+    public GetReaderCheckoutResponseDataStatusJsonConverter()
+        : base(new Dictionary<GetReaderCheckoutResponseDataStatus, string>
+        {
+            [GetReaderCheckoutResponseDataStatus.Pending] = "pending",
+            [GetReaderCheckoutResponseDataStatus.Successful] = "successful",
+            [GetReaderCheckoutResponseDataStatus.Failed] = "failed",
+            [GetReaderCheckoutResponseDataStatus.Cancelled] = "cancelled",
+        })
+    {
+    }
 }
\ No newline at end of file
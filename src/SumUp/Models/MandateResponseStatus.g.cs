@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(MandateResponseStatusJsonConverter))]
 public enum MandateResponseStatus
 {
     [EnumMember(Value = "active")]
     Active,
     [EnumMember(Value = "inactive")]
     Inactive,
+}
+
+internal sealed class MandateResponseStatusJsonConverter : EnumJsonConverter<MandateResponseStatus>
+{
+    public MandateResponseStatusJsonConverter()
+        : base(new Dictionary<MandateResponseStatus, string>
+        {
+            [MandateResponseStatus.Active] = "active",
+            [MandateResponseStatus.Inactive] = "inactive",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(MembershipStatusJsonConverter))]
 public enum MembershipStatus
 {
     [EnumMember(Value = "accepted")]
@@ -19,4 +20,19 @@ public enum MembershipStatus
     Disabled,
     [EnumMember(Value = "unknown")]
     Unknown,
+}
+
+internal sealed class MembershipStatusJsonConverter : EnumJsonConverter<MembershipStatus>
+{
+    public MembershipStatusJsonConverter()
+        : base(new Dictionary<MembershipStatus, string>
+        {
+            [MembershipStatus.Accepted] = "accepted",
+            [MembershipStatus.Pending] = "pending",
+            [MembershipStatus.Expired] = "expired",
+            [MembershipStatus.Disabled] = "disabled",
+            [MembershipStatus.Unknown] = "unknown",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -21,7 +21,7 @@ public sealed partial class Merchant
     /// <summary>Reflects the status of changes submitted through the PATCH endpoints for the Merchant or Persons. If some changes have not been applied yet, the status will be pending. If all changes have been applied, the status done. The status is only returned after write operations or on read endpoints when the version query parameter is provided.</summary>
     [JsonPropertyName("change_status")]
     [JsonInclude]
-    public string? ChangeStatus { get; private set; }
+    public string? ChangeStatus { get; internal set; }
     [JsonPropertyName("classic")]
     public ClassicMerchantIdentifiers? Classic { get; set; }
     /// <summary>Information about the company or business. This is legal information that is used for verification.</summary>
@@ -33,18 +33,18 @@ public sealed partial class Merchant
     /// <summary>The date and time when the resource was created. This is a string as defined in RFC 3339, section 5.6.</summary>
     [JsonPropertyName("created_at")]
     [JsonInclude]
-    public DateTimeOffset CreatedAt { get; private set; }
+    public DateTimeOffset CreatedAt { get; internal set; }
     /// <summary>Three-letter ISO currency code representing the default currency for the account.</summary>
     [JsonPropertyName("default_currency")]
     [JsonInclude]
-    public string DefaultCurrency { get; private set; } = default!;
+    public string DefaultCurrency { get; internal set; } = default!;
     /// <summary>Merchant's default locale, represented as a BCP47 RFC5646 language tag. This is typically an ISO 639-1 Alpha-2 ISO639‑1 language code in lowercase and an ISO 3166-1 Alpha-2 ISO3166‑1 country code in uppercase, separated by a dash. For example, en-US or fr-CA. In multilingual countries this is the merchant's preferred locale out of those, that are officially spoken in the country. In a countries with a single official language this will match the official language.</summary>
     [JsonPropertyName("default_locale")]
     public string DefaultLocale { get; set; } = default!;
     /// <summary>Short unique identifier for the merchant.</summary>
     [JsonPropertyName("merchant_code")]
     [JsonInclude]
-    public string MerchantCode { get; private set; } = default!;
+    public string MerchantCode { get; internal set; } = default!;
     /// <summary>A set of key-value pairs that you can attach to an object. This can be useful for storing additional information about the object in a structured format. Warning: Updating Meta will overwrite the existing data. Make sure to always include the complete JSON object.</summary>
     [JsonPropertyName("meta")]
     public Meta? Meta { get; set; }
@@ -57,7 +57,7 @@ public sealed partial class Merchant
     /// <summary>The date and time when the resource was last updated. This is a string as defined in RFC 3339, section 5.6.</summary>
     [JsonPropertyName("updated_at")]
     [JsonInclude]
-    public DateTimeOffset UpdatedAt { get; private set; }
+    public DateTimeOffset UpdatedAt { get; internal set; }
     /// <summary>The version of the resource. The version reflects a specific change submitted to the API via one of the PATCH endpoints.</summary>
     [JsonPropertyName("version")]
     public string? Version { get; set; }
@@ -7,10 +7,10 @@ using System.Text.Json.Serialization;
 /// <summary>Details of a saved payment instrument.</summary>
 public sealed partial class PaymentInstrumentResponse
 {
-    /// <summary>Indicates whether the payment instrument is active and can be used for payments. To deactivate it, send a DELETE request to the resource endpoint.</summary>
+    /// <summary>Indicates whether the payment instrument is active and can be used for payments. To deactivate it, send a DELETE request to the resource endpoint. Defaults to <c>true</c>.</summary>
     [JsonPropertyName("active")]
     [JsonInclude]
-    public bool? Active { get; private set; }
+    public bool? Active { get; internal set; } = true;
     /// <summary>Details of the payment card.</summary>
     [JsonPropertyName("card")]
     public PaymentInstrumentResponseCard? Card { get; set; }
@@ -23,7 +23,7 @@ public sealed partial class PaymentInstrumentResponse
     /// <summary>Unique token identifying the saved payment card for a customer.</summary>
     [JsonPropertyName("token")]
     [JsonInclude]
-    public string? Token { get; private set; }
+    public string? Token { get; internal set; }
     /// <summary>Type of the payment instrument.</summary>
     [JsonPropertyName("type")]
     public PaymentInstrumentResponseType? Type { get; set; }
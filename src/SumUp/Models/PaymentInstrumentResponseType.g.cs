@@ -3,12 +3,24 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(PaymentInstrumentResponseTypeJsonConverter))]
 public enum PaymentInstrumentResponseType
 {
     [EnumMember(Value = "card")]
     Card,
+}
+
+internal sealed class PaymentInstrumentResponseTypeJsonConverter : EnumJsonConverter<PaymentInstrumentResponseType>
+{
+    public PaymentInstrumentResponseTypeJsonConverter()
+        : base(new Dictionary<PaymentInstrumentResponseType, string>
+        {
+            [PaymentInstrumentResponseType.Card] = "card",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(PaymentTypeJsonConverter))]
 public enum PaymentType
 {
     [EnumMember(Value = "CASH")]
@@ -31,4 +32,25 @@ public enum PaymentType
     Apm,
     [EnumMember(Value = "UNKNOWN")]
     Unknown,
+}
+
+internal sealed class PaymentTypeJsonConverter : EnumJsonConverter<PaymentType>
+{
+    public PaymentTypeJsonConverter()
+        : base(new Dictionary<PaymentType, string>
+        {
+            [PaymentType.Cash] = "CASH",
+            [PaymentType.Pos] = "POS",
+            [PaymentType.Ecom] = "ECOM",
+            [PaymentType.Recurring] = "RECURRING",
+            [PaymentType.Bitcoin] = "BITCOIN",
+            [PaymentType.Balance] = "BALANCE",
+            [PaymentType.Moto] = "MOTO",
+            [PaymentType.Boleto] = "BOLETO",
+            [PaymentType.DirectDebit] = "DIRECT_DEBIT",
+            [PaymentType.Apm] = "APM",
+            [PaymentType.Unknown] = "UNKNOWN",
+        })
+    {
+    }
 }
\ No newline at end of file
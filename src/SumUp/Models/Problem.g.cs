@@ -86,6 +86,6 @@ public sealed partial class Problem
         {
             return builder.ToString();
         }
-        return JsonSerializer.Serialize(this);
+        return JsonSerializer.Serialize(this, typeof(Problem), SumUpJsonContext.Default);
     }
 }
\ No newline at end of file
@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(ReaderCheckoutStatusChangePayloadStatusJsonConverter))]
 public enum ReaderCheckoutStatusChangePayloadStatus
 {
     [EnumMember(Value = "successful")]
     Successful,
     [EnumMember(Value = "failed")]
     Failed,
+}
+
+internal sealed class ReaderCheckoutStatusChangePayloadStatusJsonConverter : EnumJsonConverter<ReaderCheckoutStatusChangePayloadStatus>
+{
+    public ReaderCheckoutStatusChangePayloadStatusJsonConverter()
+        : base(new Dictionary<ReaderCheckoutStatusChangePayloadStatus, string>
+        {
+            [ReaderCheckoutStatusChangePayloadStatus.Successful] = "successful",
+            [ReaderCheckoutStatusChangePayloadStatus.Failed] = "failed",
+        })
+    {
+    }
 }
\ No newline at end of file
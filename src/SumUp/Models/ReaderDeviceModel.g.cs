@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(ReaderDeviceModelJsonConverter))]
 public enum ReaderDeviceModel
 {
     [EnumMember(Value = "solo")]
     Solo,
     [EnumMember(Value = "virtual-solo")]
     VirtualSolo,
+}
+
+internal sealed class ReaderDeviceModelJsonConverter : EnumJsonConverter<ReaderDeviceModel>
+{
+    public ReaderDeviceModelJsonConverter()
+        : base(new Dictionary<ReaderDeviceModel, string>
+        {
+            [ReaderDeviceModel.Solo] = "solo",
+            [ReaderDeviceModel.VirtualSolo] = "virtual-solo",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(ReaderStatusJsonConverter))]
 public enum ReaderStatus
 {
     [EnumMember(Value = "unknown")]
@@ -17,4 +18,18 @@ public enum ReaderStatus
     Paired,
     [EnumMember(Value = "expired")]
     Expired,
+}
+
+internal sealed class ReaderStatusJsonConverter : EnumJsonConverter<ReaderStatus>
+{
+    public ReaderStatusJsonConverter()
+        : base(new Dictionary<ReaderStatus, string>
+        {
+            [ReaderStatus.Unknown] = "unknown",
+            [ReaderStatus.Processing] = "processing",
+            [ReaderStatus.Paired] = "paired",
+            [ReaderStatus.Expired] = "expired",
+        })
+    {
+    }
 }
\ No newline at end of file
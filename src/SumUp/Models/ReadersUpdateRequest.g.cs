@@ -8,8 +8,12 @@ public sealed partial class ReadersUpdateRequest
 {
     /// <summary>Set of user-defined key-value pairs attached to the object. Partial updates are not supported. When updating, always submit whole metadata. Maximum of 64 parameters are allowed in the object.</summary>
     [JsonPropertyName("metadata")]
-    public Metadata? Metadata { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<Metadata?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<Metadata?> Metadata { get; set; }
     /// <summary>Custom human-readable, user-defined name for easier identification of the reader.</summary>
     [JsonPropertyName("name")]
-    public string? Name { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<string?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<string?> Name { get; set; }
 }
\ No newline at end of file
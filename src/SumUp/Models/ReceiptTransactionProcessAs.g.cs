@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(ReceiptTransactionProcessAsJsonConverter))]
 public enum ReceiptTransactionProcessAs
 {
     [EnumMember(Value = "CREDIT")]
     Credit,
     [EnumMember(Value = "DEBIT")]
     Debit,
+}
+
+internal sealed class ReceiptTransactionProcessAsJsonConverter : EnumJsonConverter<ReceiptTransactionProcessAs>
+{
+    public ReceiptTransactionProcessAsJsonConverter()
+        : base(new Dictionary<ReceiptTransactionProcessAs, string>
+        {
+            [ReceiptTransactionProcessAs.Credit] = "CREDIT",
+            [ReceiptTransactionProcessAs.Debit] = "DEBIT",
+        })
+    {
+    }
 }
\ No newline at end of file
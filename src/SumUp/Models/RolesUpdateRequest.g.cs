@@ -9,11 +9,17 @@ public sealed partial class RolesUpdateRequest
 {
     /// <summary>User-defined description of the role.</summary>
     [JsonPropertyName("description")]
-    public string? Description { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<string?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<string?> Description { get; set; }
     /// <summary>User-defined name of the role.</summary>
     [JsonPropertyName("name")]
-    public string? Name { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<string?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<string?> Name { get; set; }
     /// <summary>User's permissions.</summary>
     [JsonPropertyName("permissions")]
-    public IEnumerable<string>? Permissions { get; set; }
+    [JsonConverter(typeof(OptionalJsonConverter<IEnumerable<string>?>))]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingDefault)]
+    public Optional<IEnumerable<string>?> Permissions { get; set; }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(StatusResponseDataConnectionTypeJsonConverter))]
 public enum StatusResponseDataConnectionType
 {
     [EnumMember(Value = "btle")]
@@ -23,4 +24,21 @@ public enum StatusResponseDataConnectionType
     Usb,
     [EnumMember(Value = "Wi-Fi")]
     WiFi,
+}
+
+internal sealed class StatusResponseDataConnectionTypeJsonConverter : EnumJsonConverter<StatusResponseDataConnectionType>
+{
+    public StatusResponseDataConnectionTypeJsonConverter()
+        : base(new Dictionary<StatusResponseDataConnectionType, string>
+        {
+            [StatusResponseDataConnectionType.Btle] = "btle",
+            [StatusResponseDataConnectionType.Edge] = "edge",
+            [StatusResponseDataConnectionType.Gprs] = "gprs",
+            [StatusResponseDataConnectionType.Lte] = "lte",
+            [StatusResponseDataConnectionType.Umts] = "umts",
+            [StatusResponseDataConnectionType.Usb] = "usb",
+            [StatusResponseDataConnectionType.WiFi] = "Wi-Fi",
+        })
+    {
+    }
 }
\ No newline at end of file
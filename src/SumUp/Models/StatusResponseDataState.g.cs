@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(StatusResponseDataStateJsonConverter))]
 public enum StatusResponseDataState
 {
     [EnumMember(Value = "IDLE")]
@@ -21,4 +22,20 @@ public enum StatusResponseDataState
     WaitingForSignature,
     [EnumMember(Value = "UPDATING_FIRMWARE")]
     UpdatingFirmware,
+}
+
+internal sealed class StatusResponseDataStateJsonConverter : EnumJsonConverter<StatusResponseDataState>
+{
+    public StatusResponseDataStateJsonConverter()
+        : base(new Dictionary<StatusResponseDataState, string>
+        {
+            [StatusResponseDataState.Idle] = "IDLE",
+            [StatusResponseDataState.SelectingTip] = "SELECTING_TIP",
+            [StatusResponseDataState.WaitingForCard] = "WAITING_FOR_CARD",
+            [StatusResponseDataState.WaitingForPin] = "WAITING_FOR_PIN",
+            [StatusResponseDataState.WaitingForSignature] = "WAITING_FOR_SIGNATURE",
+            [StatusResponseDataState.UpdatingFirmware] = "UPDATING_FIRMWARE",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(StatusResponseDataStatusJsonConverter))]
 public enum StatusResponseDataStatus
 {
     [EnumMember(Value = "ONLINE")]
     Online,
     [EnumMember(Value = "OFFLINE")]
     Offline,
+}
+
+internal sealed class StatusResponseDataStatusJsonConverter : EnumJsonConverter<StatusResponseDataStatus>
+{
+    public StatusResponseDataStatusJsonConverter()
+        : base(new Dictionary<StatusResponseDataStatus, string>
+        {
+            [StatusResponseDataStatus.Online] = "ONLINE",
+            [StatusResponseDataStatus.Offline] = "OFFLINE",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -9,9 +9,9 @@ public sealed partial class Timestamps
     /// <summary>The date and time when the resource was created. This is a string as defined in RFC 3339, section 5.6.</summary>
     [JsonPropertyName("created_at")]
     [JsonInclude]
-    public DateTimeOffset CreatedAt { get; private set; }
+    public DateTimeOffset CreatedAt { get; internal set; }
     /// <summary>The date and time when the resource was last updated. This is a string as defined in RFC 3339, section 5.6.</summary>
     [JsonPropertyName("updated_at")]
     [JsonInclude]
-    public DateTimeOffset UpdatedAt { get; private set; }
+    public DateTimeOffset UpdatedAt { get; internal set; }
 }
\ No newline at end of file
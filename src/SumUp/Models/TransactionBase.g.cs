@@ -5,7 +5,7 @@ namespace SumUp;
 
 using System.Text.Json.Serialization;
 /// <summary>Core details shared by transaction resources.</summary>
-public sealed partial class TransactionBase
+public partial class TransactionBase
 {
     /// <summary>Total amount of the transaction.</summary>
     [JsonPropertyName("amount")]
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionEventStatusJsonConverter))]
 public enum TransactionEventStatus
 {
     [EnumMember(Value = "FAILED")]
@@ -23,4 +24,21 @@ public enum TransactionEventStatus
     Scheduled,
     [EnumMember(Value = "SUCCESSFUL")]
     Successful,
+}
+
+internal sealed class TransactionEventStatusJsonConverter : EnumJsonConverter<TransactionEventStatus>
+{
+    public TransactionEventStatusJsonConverter()
+        : base(new Dictionary<TransactionEventStatus, string>
+        {
+            [TransactionEventStatus.Failed] = "FAILED",
+            [TransactionEventStatus.PaidOut] = "PAID_OUT",
+            [TransactionEventStatus.Pending] = "PENDING",
+            [TransactionEventStatus.Reconciled] = "RECONCILED",
+            [TransactionEventStatus.Refunded] = "REFUNDED",
+            [TransactionEventStatus.Scheduled] = "SCHEDULED",
+            [TransactionEventStatus.Successful] = "SUCCESSFUL",
+        })
+    {
+    }
 }
\ No newline at end of file
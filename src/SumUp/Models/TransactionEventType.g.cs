@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionEventTypeJsonConverter))]
 public enum TransactionEventType
 {
     [EnumMember(Value = "PAYOUT")]
@@ -17,4 +18,18 @@ public enum TransactionEventType
     Refund,
     [EnumMember(Value = "PAYOUT_DEDUCTION")]
     PayoutDeduction,
+}
+
+internal sealed class TransactionEventTypeJsonConverter : EnumJsonConverter<TransactionEventType>
+{
+    public TransactionEventTypeJsonConverter()
+        : base(new Dictionary<TransactionEventType, string>
+        {
+            [TransactionEventType.Payout] = "PAYOUT",
+            [TransactionEventType.ChargeBack] = "CHARGE_BACK",
+            [TransactionEventType.Refund] = "REFUND",
+            [TransactionEventType.PayoutDeduction] = "PAYOUT_DEDUCTION",
+        })
+    {
+    }
 }
\ No newline at end of file
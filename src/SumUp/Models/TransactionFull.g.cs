@@ -6,11 +6,8 @@ namespace SumUp;
 using System.Text.Json.Serialization;
 using System.Collections.Generic;
 /// <summary>Full transaction resource with checkout, payout, and event details.</summary>
-public sealed partial class TransactionFull
+public sealed partial class TransactionFull : TransactionBase
 {
-    /// <summary>Total amount of the transaction.</summary>
-    [JsonPropertyName("amount")]
-    public float? Amount { get; set; }
     /// <summary>Authorization code for the transaction sent by the payment card issuer or bank. Applicable only to card payments.</summary>
     [JsonPropertyName("auth_code")]
     public string? AuthCode { get; set; }
@@ -20,9 +17,6 @@ public sealed partial class TransactionFull
     /// <summary>Client-supplied identifier of the transaction.</summary>
     [JsonPropertyName("client_transaction_id")]
     public string? ClientTransactionId { get; set; }
-    /// <summary>Three-letter ISO 4217 currency code of the amount.</summary>
-    [JsonPropertyName("currency")]
-    public Currency? Currency { get; set; }
     /// <summary>Details of the device used to create the transaction.</summary>
     [JsonPropertyName("device_info")]
     public Device? DeviceInfo { get; set; }
@@ -44,12 +38,6 @@ public sealed partial class TransactionFull
     /// <summary>Indication of the precision of the geographical position received from the payment terminal.</summary>
     [JsonPropertyName("horizontal_accuracy")]
     public float? HorizontalAccuracy { get; set; }
-    /// <summary>Unique identifier of the transaction.</summary>
-    [JsonPropertyName("id")]
-    public string? Id { get; set; }
-    /// <summary>Number of installments for a deferred payment.</summary>
-    [JsonPropertyName("installments_count")]
-    public int? InstallmentsCount { get; set; }
     /// <summary>Latitude value from the coordinates of the payment location (as received from the payment terminal reader).</summary>
     [JsonPropertyName("lat")]
     public float? Lat { get; set; }
@@ -71,9 +59,6 @@ public sealed partial class TransactionFull
     /// <summary>Internal SumUp identifier of the merchant.</summary>
     [JsonPropertyName("merchant_id")]
     public long? MerchantId { get; set; }
-    /// <summary>Payment type used for the transaction.</summary>
-    [JsonPropertyName("payment_type")]
-    public PaymentType? PaymentType { get; set; }
     /// <summary>The date of the payout.</summary>
     [JsonPropertyName("payout_date")]
     public DateOnly? PayoutDate { get; set; }
@@ -104,21 +89,12 @@ public sealed partial class TransactionFull
     /// <summary>High-level status of the transaction from the merchant's perspective. - PENDING: The payment has been initiated and is still being processed. A final outcome is not available yet. - SUCCESSFUL: The payment was completed successfully. - PAID_OUT: The payment was completed successfully and the funds have already been included in a payout to the merchant. - FAILED: The payment did not complete successfully. - CANCELLED: The payment was cancelled or reversed and is no longer payable or payable to the merchant. - CANCEL_FAILED: An attempt to cancel or reverse the payment was not completed successfully. - REFUNDED: The payment was refunded in full or in part. - REFUND_FAILED: An attempt to refund the payment was not completed successfully. - CHARGEBACK: The payment was subject to a chargeback. - NON_COLLECTION: The amount could not be collected from the merchant after a chargeback or related adjustment.</summary>
     [JsonPropertyName("simple_status")]
     public TransactionFullSimpleStatus? SimpleStatus { get; set; }
-    /// <summary>Current status of the transaction. - PENDING: The transaction has been created but its final outcome is not known yet. - SUCCESSFUL: The transaction completed successfully. - CANCELLED: The transaction was cancelled or otherwise reversed before completion. - FAILED: The transaction attempt did not complete successfully. - REFUNDED: The transaction was refunded in full or in part.</summary>
-    [JsonPropertyName("status")]
-    public TransactionStatus? Status { get; set; }
     /// <summary>Indicates whether tax deduction is enabled for the transaction.</summary>
     [JsonPropertyName("tax_enabled")]
     public bool? TaxEnabled { get; set; }
-    /// <summary>The timestamp of when the transaction was created.</summary>
-    [JsonPropertyName("timestamp")]
-    public DateTimeOffset? Timestamp { get; set; }
     /// <summary>Amount of the tip (out of the total transaction amount).</summary>
     [JsonPropertyName("tip_amount")]
     public float? TipAmount { get; set; }
-    /// <summary>Transaction code returned by the acquirer/processing entity after processing the transaction.</summary>
-    [JsonPropertyName("transaction_code")]
-    public string? TransactionCode { get; set; }
     /// <summary>Detailed list of events related to the transaction.</summary>
     [JsonPropertyName("transaction_events")]
     public IEnumerable<TransactionEvent>? TransactionEvents { get; set; }
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionFullPayoutPlanJsonConverter))]
 public enum TransactionFullPayoutPlan
 {
     [EnumMember(Value = "SINGLE_PAYMENT")]
@@ -15,4 +16,17 @@ public enum TransactionFullPayoutPlan
     TrueInstallment,
     [EnumMember(Value = "ACCELERATED_INSTALLMENT")]
     AcceleratedInstallment,
+}
+
+internal sealed class TransactionFullPayoutPlanJsonConverter : EnumJsonConverter<TransactionFullPayoutPlan>
+{
+    public TransactionFullPayoutPlanJsonConverter()
+        : base(new Dictionary<TransactionFullPayoutPlan, string>
+        {
+            [TransactionFullPayoutPlan.SinglePayment] = "SINGLE_PAYMENT",
+            [TransactionFullPayoutPlan.TrueInstallment] = "TRUE_INSTALLMENT",
+            [TransactionFullPayoutPlan.AcceleratedInstallment] = "ACCELERATED_INSTALLMENT",
+        })
+    {
+    }
 }
\ No newline at end of file
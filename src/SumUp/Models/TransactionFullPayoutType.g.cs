@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionFullPayoutTypeJsonConverter))]
 public enum TransactionFullPayoutType
 {
     [EnumMember(Value = "BANK_ACCOUNT")]
     BankAccount,
     [EnumMember(Value = "PREPAID_CARD")]
     PrepaidCard,
+}
+
+internal sealed class TransactionFullPayoutTypeJsonConverter : EnumJsonConverter<TransactionFullPayoutType>
+{
+    public TransactionFullPayoutTypeJsonConverter()
+        : base(new Dictionary<TransactionFullPayoutType, string>
+        {
+            [TransactionFullPayoutType.BankAccount] = "BANK_ACCOUNT",
+            [TransactionFullPayoutType.PrepaidCard] = "PREPAID_CARD",
+        })
+    {
+    }
 }
\ No newline at end of file
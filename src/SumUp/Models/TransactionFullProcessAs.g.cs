@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionFullProcessAsJsonConverter))]
 public enum TransactionFullProcessAs
 {
     [EnumMember(Value = "CREDIT")]
     Credit,
     [EnumMember(Value = "DEBIT")]
     Debit,
+}
+
+internal sealed class TransactionFullProcessAsJsonConverter : EnumJsonConverter<TransactionFullProcessAs>
+{
+    public TransactionFullProcessAsJsonConverter()
+        : base(new Dictionary<TransactionFullProcessAs, string>
+        {
+            [TransactionFullProcessAs.Credit] = "CREDIT",
+            [TransactionFullProcessAs.Debit] = "DEBIT",
+        })
+    {
+    }
 }
\ No newline at end of file
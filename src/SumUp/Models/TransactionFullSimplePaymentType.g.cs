@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionFullSimplePaymentTypeJsonConverter))]
 public enum TransactionFullSimplePaymentType
 {
     [EnumMember(Value = "CASH")]
@@ -37,4 +38,28 @@ public enum TransactionFullSimplePaymentType
     Bitcoin,
     [EnumMember(Value = "CARD")]
     Card,
+}
+
+internal sealed class TransactionFullSimplePaymentTypeJsonConverter : EnumJsonConverter<TransactionFullSimplePaymentType>
+{
+    public TransactionFullSimplePaymentTypeJsonConverter()
+        : base(new Dictionary<TransactionFullSimplePaymentType, string>
+        {
+            [TransactionFullSimplePaymentType.Cash] = "CASH",
+            [TransactionFullSimplePaymentType.CcSignature] = "CC_SIGNATURE",
+            [TransactionFullSimplePaymentType.Elv] = "ELV",
+            [TransactionFullSimplePaymentType.ElvWithoutSignature] = "ELV_WITHOUT_SIGNATURE",
+            [TransactionFullSimplePaymentType.CcCustomerEntered] = "CC_CUSTOMER_ENTERED",
+            [TransactionFullSimplePaymentType.ManualEntry] = "MANUAL_ENTRY",
+            [TransactionFullSimplePaymentType.Emv] = "EMV",
+            [TransactionFullSimplePaymentType.Recurring] = "RECURRING",
+            [TransactionFullSimplePaymentType.Balance] = "BALANCE",
+            [TransactionFullSimplePaymentType.Moto] = "MOTO",
+            [TransactionFullSimplePaymentType.Boleto] = "BOLETO",
+            [TransactionFullSimplePaymentType.Apm] = "APM",
+            [TransactionFullSimplePaymentType.Bitcoin] = "BITCOIN",
+            [TransactionFullSimplePaymentType.Card] = "CARD",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionFullSimpleStatusJsonConverter))]
 public enum TransactionFullSimpleStatus
 {
     [EnumMember(Value = "SUCCESSFUL")]
@@ -29,4 +30,24 @@ public enum TransactionFullSimpleStatus
     NonCollection,
     [EnumMember(Value = "PENDING")]
     Pending,
+}
+
+internal sealed class TransactionFullSimpleStatusJsonConverter : EnumJsonConverter<TransactionFullSimpleStatus>
+{
+    public TransactionFullSimpleStatusJsonConverter()
+        : base(new Dictionary<TransactionFullSimpleStatus, string>
+        {
+            [TransactionFullSimpleStatus.Successful] = "SUCCESSFUL",
+            [TransactionFullSimpleStatus.PaidOut] = "PAID_OUT",
+            [TransactionFullSimpleStatus.CancelFailed] = "CANCEL_FAILED",
+            [TransactionFullSimpleStatus.Cancelled] = "CANCELLED",
+            [TransactionFullSimpleStatus.Chargeback] = "CHARGEBACK",
+            [TransactionFullSimpleStatus.Failed] = "FAILED",
+            [TransactionFullSimpleStatus.RefundFailed] = "REFUND_FAILED",
+            [TransactionFullSimpleStatus.Refunded] = "REFUNDED",
+            [TransactionFullSimpleStatus.NonCollection] = "NON_COLLECTION",
+            [TransactionFullSimpleStatus.Pending] = "PENDING",
+        })
+    {
+    }
 }
\ No newline at end of file
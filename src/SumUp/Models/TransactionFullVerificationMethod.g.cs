@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionFullVerificationMethodJsonConverter))]
 public enum TransactionFullVerificationMethod
 {
     [EnumMember(Value = "none")]
@@ -21,4 +22,20 @@ public enum TransactionFullVerificationMethod
     OfflinePinSignature,
     [EnumMember(Value = "na")]
     Na,
+}
+
+internal sealed class TransactionFullVerificationMethodJsonConverter : EnumJsonConverter<TransactionFullVerificationMethod>
+{
+    public TransactionFullVerificationMethodJsonConverter()
+        : base(new Dictionary<TransactionFullVerificationMethod, string>
+        {
+            [TransactionFullVerificationMethod.None] = "none",
+            [TransactionFullVerificationMethod.Signature] = "signature",
+            [TransactionFullVerificationMethod.OfflinePin] = "offline PIN",
+            [TransactionFullVerificationMethod.OnlinePin] = "online PIN",
+            [TransactionFullVerificationMethod.OfflinePinSignature] = "offline PIN + signature",
+            [TransactionFullVerificationMethod.Na] = "na",
+        })
+    {
+    }
 }
\ No newline at end of file
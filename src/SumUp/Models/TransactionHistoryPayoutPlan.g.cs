@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionHistoryPayoutPlanJsonConverter))]
 public enum TransactionHistoryPayoutPlan
 {
     [EnumMember(Value = "SINGLE_PAYMENT")]
@@ -15,4 +16,17 @@ public enum TransactionHistoryPayoutPlan
     TrueInstallment,
     [EnumMember(Value = "ACCELERATED_INSTALLMENT")]
     AcceleratedInstallment,
+}
+
+internal sealed class TransactionHistoryPayoutPlanJsonConverter : EnumJsonConverter<TransactionHistoryPayoutPlan>
+{
+    public TransactionHistoryPayoutPlanJsonConverter()
+        : base(new Dictionary<TransactionHistoryPayoutPlan, string>
+        {
+            [TransactionHistoryPayoutPlan.SinglePayment] = "SINGLE_PAYMENT",
+            [TransactionHistoryPayoutPlan.TrueInstallment] = "TRUE_INSTALLMENT",
+            [TransactionHistoryPayoutPlan.AcceleratedInstallment] = "ACCELERATED_INSTALLMENT",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionHistoryPayoutTypeJsonConverter))]
 public enum TransactionHistoryPayoutType
 {
     [EnumMember(Value = "BANK_ACCOUNT")]
     BankAccount,
     [EnumMember(Value = "PREPAID_CARD")]
     PrepaidCard,
+}
+
+internal sealed class TransactionHistoryPayoutTypeJsonConverter : EnumJsonConverter<TransactionHistoryPayoutType>
+{
+    public TransactionHistoryPayoutTypeJsonConverter()
+        : base(new Dictionary<TransactionHistoryPayoutType, string>
+        {
+            [TransactionHistoryPayoutType.BankAccount] = "BANK_ACCOUNT",
+            [TransactionHistoryPayoutType.PrepaidCard] = "PREPAID_CARD",
+        })
+    {
+    }
 }
\ No newline at end of file
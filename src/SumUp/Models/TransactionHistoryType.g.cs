@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionHistoryTypeJsonConverter))]
 public enum TransactionHistoryType
 {
     [EnumMember(Value = "PAYMENT")]
@@ -15,4 +16,17 @@ public enum TransactionHistoryType
     Refund,
     [EnumMember(Value = "CHARGE_BACK")]
     ChargeBack,
+}
+
+internal sealed class TransactionHistoryTypeJsonConverter : EnumJsonConverter<TransactionHistoryType>
+{
+    public TransactionHistoryTypeJsonConverter()
+        : base(new Dictionary<TransactionHistoryType, string>
+        {
+            [TransactionHistoryType.Payment] = "PAYMENT",
+            [TransactionHistoryType.Refund] = "REFUND",
+            [TransactionHistoryType.ChargeBack] = "CHARGE_BACK",
+        })
+    {
+    }
 }
\ No newline at end of file
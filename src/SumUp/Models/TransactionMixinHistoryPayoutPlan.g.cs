@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionMixinHistoryPayoutPlanJsonConverter))]
 public enum TransactionMixinHistoryPayoutPlan
 {
     [EnumMember(Value = "SINGLE_PAYMENT")]
@@ -15,4 +16,17 @@ public enum TransactionMixinHistoryPayoutPlan
     TrueInstallment,
     [EnumMember(Value = "ACCELERATED_INSTALLMENT")]
     AcceleratedInstallment,
+}
+
+internal sealed class TransactionMixinHistoryPayoutPlanJsonConverter : EnumJsonConverter<TransactionMixinHistoryPayoutPlan>
+{
+    public TransactionMixinHistoryPayoutPlanJsonConverter()
+        : base(new Dictionary<TransactionMixinHistoryPayoutPlan, string>
+        {
+            [TransactionMixinHistoryPayoutPlan.SinglePayment] = "SINGLE_PAYMENT",
+            [TransactionMixinHistoryPayoutPlan.TrueInstallment] = "TRUE_INSTALLMENT",
+            [TransactionMixinHistoryPayoutPlan.AcceleratedInstallment] = "ACCELERATED_INSTALLMENT",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(TransactionStatusJsonConverter))]
 public enum TransactionStatus
 {
     [EnumMember(Value = "SUCCESSFUL")]
@@ -19,4 +20,19 @@ public enum TransactionStatus
     Pending,
     [EnumMember(Value = "REFUNDED")]
     Refunded,
+}
+
+internal sealed class TransactionStatusJsonConverter : EnumJsonConverter<TransactionStatus>
+{
+    public TransactionStatusJsonConverter()
+        : base(new Dictionary<TransactionStatus, string>
+        {
+            [TransactionStatus.Successful] = "SUCCESSFUL",
+            [TransactionStatus.Cancelled] = "CANCELLED",
+            [TransactionStatus.Failed] = "FAILED",
+            [TransactionStatus.Pending] = "PENDING",
+            [TransactionStatus.Refunded] = "REFUNDED",
+        })
+    {
+    }
 }
\ No newline at end of file
@@ -3,14 +3,27 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(UnauthorizedErrorsTypeJsonConverter))]
 public enum UnauthorizedErrorsType
 {
     [EnumMember(Value = "INVALID_ACCESS_TOKEN")]
     InvalidAccessToken,
     [EnumMember(Value = "INVALID_PASSWORD")]
     InvalidPassword,
+}
+
+internal sealed class UnauthorizedErrorsTypeJsonConverter : EnumJsonConverter<UnauthorizedErrorsType>
+{
+    public UnauthorizedErrorsTypeJsonConverter()
+        : base(new Dictionary<UnauthorizedErrorsType, string>
+        {
+            [UnauthorizedErrorsType.InvalidAccessToken] = "INVALID_ACCESS_TOKEN",
+            [UnauthorizedErrorsType.InvalidPassword] = "INVALID_PASSWORD",
+        })
+    {
+    }
 }
\ No newline at end of file
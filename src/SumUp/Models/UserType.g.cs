@@ -3,10 +3,11 @@
 
 namespace SumUp;
 
+using System.Collections.Generic;
 using System.Runtime.Serialization;
 using System.Text.Json.Serialization;
 
-[JsonConverter(typeof(EnumMemberJsonConverterFactory))]
+[JsonConverter(typeof(UserTypeJsonConverter))]
 public enum UserType
 {
     [EnumMember(Value = "user")]
@@ -17,4 +18,18 @@ public enum UserType
     ServiceAccount,
     [EnumMember(Value = "system_account")]
     SystemAccount,
+}
+
+internal sealed class UserTypeJsonConverter : EnumJsonConverter<UserType>
+{
+    public UserTypeJsonConverter()
+        : base(new Dictionary<UserType, string>
+        {
+            [UserType.User] = "user",
+            [UserType.ManagedUser] = "managed_user",
+            [UserType.ServiceAccount] = "service_account",
+            [UserType.SystemAccount] = "system_account",
+        })
+    {
+    }
 }
\ No newline at end of file
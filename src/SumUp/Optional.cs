@@ -0,0 +1,108 @@
+using System;
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+using System.Text.Json.Serialization.Metadata;
+
+namespace SumUp;
+
+/// <summary>
+/// Represents a request body property that can be omitted, set to a value, or set explicitly to null.
+/// Partial updates use it to tell "leave this field alone" apart from "clear this field".
+/// </summary>
+public readonly struct Optional<T> : IEquatable<Optional<T>>
+{
+    private readonly T _value;
+
+    /// <summary>
+    /// Indicates whether the property should be serialized.
+    /// </summary>
+    public bool IsSet { get; }
+
+    /// <summary>
+    /// Indicates whether the property should be serialized as an explicit null literal.
+    /// </summary>
+    public bool IsNull => IsSet && _value is null;
+
+    /// <summary>
+    /// The value of a set property.
+    /// </summary>
+    /// <exception cref="InvalidOperationException">The property is unset.</exception>
+    public T Value => IsSet ? _value : throw new InvalidOperationException("The optional value is not set.");
+
+    private Optional(T value)
+    {
+        IsSet = true;
+        _value = value;
+    }
+
+    /// <summary>
+    /// Omits the property from the request body.
+    /// </summary>
+    public static Optional<T> Unset => default;
+
+    /// <summary>
+    /// Serializes the property as an explicit null literal.
+    /// </summary>
+    public static Optional<T> Null() => new(default!);
+
+    /// <summary>
+    /// Serializes the property with the provided value.
+    /// </summary>
+    public static Optional<T> From(T value) => new(value);
+
+    /// <summary>
+    /// Returns the value of a set property, or <paramref name="defaultValue"/> when it is unset.
+    /// </summary>
+    public T GetValueOrDefault(T defaultValue = default!) => IsSet ? _value : defaultValue;
+
+    public static implicit operator Optional<T>(T value) => From(value);
+
+    public static bool operator ==(Optional<T> left, Optional<T> right) => left.Equals(right);
+
+    public static bool operator !=(Optional<T> left, Optional<T> right) => !left.Equals(right);
+
+    /// <inheritdoc />
+    public bool Equals(Optional<T> other) =>
+        IsSet == other.IsSet && EqualityComparer<T>.Default.Equals(_value, other._value);
+
+    /// <inheritdoc />
+    public override bool Equals(object? obj) => obj is Optional<T> other && Equals(other);
+
+    /// <inheritdoc />
+    public override int GetHashCode() => IsSet ? HashCode.Combine(true, _value) : 0;
+
+    /// <inheritdoc />
+    public override string ToString() => IsSet ? _value?.ToString() ?? "null" : "unset";
+}
+
+/// <summary>
+/// Serializes <see cref="Optional{T}"/> as its value, or null while unset or null. Generated models name the
+/// closed converter on each property, so it is created without reflection, and omit the property while unset
+/// because they ignore it when it holds the default value.
+/// </summary>
+internal sealed class OptionalJsonConverter<T> : JsonConverter<Optional<T>>
+{
+    public override bool HandleNull => true;
+
+    public override Optional<T> Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)
+    {
+        if (reader.TokenType == JsonTokenType.Null)
+        {
+            return Optional<T>.Null();
+        }
+
+        return Optional<T>.From(JsonSerializer.Deserialize(ref reader, (JsonTypeInfo<T>)options.GetTypeInfo(typeof(T)))!);
+    }
+
+    public override void Write(Utf8JsonWriter writer, Optional<T> value, JsonSerializerOptions options)
+    {
+        if (!value.IsSet || value.IsNull)
+        {
+            writer.WriteNullValue();
+            return;
+        }
+
+        JsonSerializer.Serialize(writer, value.Value, (JsonTypeInfo<T>)options.GetTypeInfo(typeof(T)));
+    }
+}
@@ -9,11 +9,11 @@ using System.Collections.Generic;
 /// </summary>
 public sealed partial class MembersListOptions
 {
-    /// <summary>Offset of the first member to return.</summary>
+    /// <summary>Offset of the first member to return. Defaults to <c>0</c>.</summary>
     public int? Offset { get; set; }
-    /// <summary>Maximum number of members to return.</summary>
+    /// <summary>Maximum number of members to return. Defaults to <c>10</c>.</summary>
     public int? Limit { get; set; }
-    /// <summary>Indicates to skip count query.</summary>
+    /// <summary>Indicates to skip count query. Defaults to <c>false</c>.</summary>
     public bool? Scroll { get; set; }
     /// <summary>Filter the returned members by email address prefix.</summary>
     public string? Email { get; set; }
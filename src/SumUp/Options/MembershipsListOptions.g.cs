@@ -9,9 +9,9 @@ using System.Collections.Generic;
 /// </summary>
 public sealed partial class MembershipsListOptions
 {
-    /// <summary>Offset of the first member to return.</summary>
+    /// <summary>Offset of the first member to return. Defaults to <c>0</c>.</summary>
     public int? Offset { get; set; }
-    /// <summary>Maximum number of members to return.</summary>
+    /// <summary>Maximum number of members to return. Defaults to <c>10</c>.</summary>
     public int? Limit { get; set; }
     /// <summary>Filter memberships by resource kind.</summary>
     public string? Kind { get; set; }
@@ -12,10 +12,10 @@ public sealed partial class PayoutsListOptions
     public DateOnly StartDate { get; set; }
     /// <summary>End date of the payout period filter, inclusive, in ISO8601 date format (YYYY-MM-DD). Must be greater than or equal to start_date.</summary>
     public DateOnly EndDate { get; set; }
-    /// <summary>Response format for the payout list.</summary>
+    /// <summary>Response format for the payout list. Defaults to <c>json</c>.</summary>
     public string? Format { get; set; }
     /// <summary>Maximum number of payout records to return.</summary>
     public int? Limit { get; set; }
-    /// <summary>Sort direction for the returned payouts.</summary>
+    /// <summary>Sort direction for the returned payouts. Defaults to <c>asc</c>.</summary>
     public string? Order { get; set; }
 }
\ No newline at end of file
@@ -11,7 +11,7 @@ public sealed partial class TransactionsListOptions
 {
     /// <summary>Retrieves the transaction resource with the specified transaction code.</summary>
     public string? TransactionCode { get; set; }
-    /// <summary>Specifies the order in which the returned results are displayed.</summary>
+    /// <summary>Specifies the order in which the returned results are displayed. Defaults to <c>ascending</c>.</summary>
     public string? Order { get; set; }
     /// <summary>Specifies the maximum number of results per page. Value must be a positive integer and if not specified, will return 10 results.</summary>
     public int? Limit { get; set; }
@@ -40,9 +40,9 @@ public sealed partial class PayoutsClient
             builder.AddPath("merchant_code", merchantCode);
             builder.AddQuery("start_date", operationOptions.StartDate);
             builder.AddQuery("end_date", operationOptions.EndDate);
-            builder.AddQuery("format", operationOptions.Format);
+            builder.AddQuery("format", operationOptions.Format, "json");
             builder.AddQuery("limit", operationOptions.Limit);
-            builder.AddQuery("order", operationOptions.Order);
+            builder.AddQuery("order", operationOptions.Order, "asc");
         });
         var effectiveCancellationToken = ApiClient.CreateCancellationToken(cancellationToken, requestOptions, out var timeoutScope);
         try
@@ -76,7 +76,7 @@ public sealed partial class PayoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<IEnumerable<FinancialPayout>>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<IEnumerable<FinancialPayout>>());
             return ApiResponse<IEnumerable<FinancialPayout>>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -101,9 +101,9 @@ public sealed partial class PayoutsClient
             builder.AddPath("merchant_code", merchantCode);
             builder.AddQuery("start_date", operationOptions.StartDate);
             builder.AddQuery("end_date", operationOptions.EndDate);
-            builder.AddQuery("format", operationOptions.Format);
+            builder.AddQuery("format", operationOptions.Format, "json");
             builder.AddQuery("limit", operationOptions.Limit);
-            builder.AddQuery("order", operationOptions.Order);
+            builder.AddQuery("order", operationOptions.Order, "asc");
         });
         var effectiveCancellationToken = ApiClient.CreateCancellationToken(cancellationToken, requestOptions, out var timeoutScope);
         try
@@ -137,7 +137,7 @@ public sealed partial class PayoutsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<IEnumerable<FinancialPayout>>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<IEnumerable<FinancialPayout>>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<IEnumerable<FinancialPayout>>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
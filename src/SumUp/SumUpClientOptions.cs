@@ -11,6 +11,7 @@ namespace SumUp;
 public sealed class SumUpClientOptions
 {
     private const string AccessTokenEnvironmentVariable = "SUMUP_ACCESS_TOKEN";
+    private const string BaseUrlEnvironmentVariable = "SUMUP_BASE_URL";
 
     /// <summary>
     /// Gets or sets the base address for API requests.
@@ -37,18 +38,31 @@ public sealed class SumUpClientOptions
     /// </summary>
     public string UserAgent { get; set; } = PackageInfo.UserAgent;
 
+    /// <summary>
+    /// Gets or sets whether query and header parameters equal to their documented default are left out of
+    /// requests, which keeps URLs minimal and in line with the server's cache keys. Disabled by default, so
+    /// every value the caller passes is sent.
+    /// </summary>
+    public bool OmitDefaultParameters { get; set; }
+
     /// <summary>
     /// Supply a custom <see cref="HttpClient"/> instance when you need to control its lifecycle (for DI scenarios).
     /// </summary>
     public HttpClient? HttpClient { get; set; }
 
     /// <summary>
-    /// Creates a new instance populated with defaults and the environment access token (if available).
+    /// Creates a new instance populated with defaults, the environment access token (if available)
+    /// and the base address from <c>SUMUP_BASE_URL</c> (if set), e.g. to target a local mock server.
     /// </summary>
     public static SumUpClientOptions FromEnvironment()
     {
         var options = new SumUpClientOptions();
         options.AccessToken = options.TryReadTokenFromEnvironment();
+        var baseUrl = Environment.GetEnvironmentVariable(BaseUrlEnvironmentVariable);
+        if (!string.IsNullOrWhiteSpace(baseUrl) && Uri.TryCreate(baseUrl, UriKind.Absolute, out var baseAddress))
+        {
+            options.BaseAddress = baseAddress;
+        }
         return options;
     }
 
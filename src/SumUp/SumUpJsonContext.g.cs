@@ -0,0 +1,181 @@
+// <auto-generated />
+#nullable enable
+
+namespace SumUp;
+
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+/// <summary>
+/// Source-generated serialization metadata of the models, request bodies, responses and errors of the SDK, so
+/// clients serialize without reflection when trimmed or compiled with NativeAOT.
+/// </summary>
+[JsonSourceGenerationOptions(JsonSerializerDefaults.Web, PropertyNameCaseInsensitive = true)]
+[JsonSerializable(typeof(Address))]
+[JsonSerializable(typeof(AddressLegacy))]
+[JsonSerializable(typeof(Affiliate))]
+[JsonSerializable(typeof(Amount))]
+[JsonSerializable(typeof(global::SumUp.Http.ApiError))]
+[JsonSerializable(typeof(Attributes))]
+[JsonSerializable(typeof(BadRequest))]
+[JsonSerializable(typeof(BadRequestErrors))]
+[JsonSerializable(typeof(BadRequestErrorsType))]
+[JsonSerializable(typeof(BasePerson))]
+[JsonSerializable(typeof(Branding))]
+[JsonSerializable(typeof(BusinessProfile))]
+[JsonSerializable(typeof(CardResponse))]
+[JsonSerializable(typeof(CardType))]
+[JsonSerializable(typeof(Checkout))]
+[JsonSerializable(typeof(CheckoutCreateRequest))]
+[JsonSerializable(typeof(CheckoutCreateRequestPurpose))]
+[JsonSerializable(typeof(CheckoutStatus))]
+[JsonSerializable(typeof(CheckoutSuccess))]
+[JsonSerializable(typeof(CheckoutSuccessPaymentInstrument))]
+[JsonSerializable(typeof(CheckoutTransactionsItem))]
+[JsonSerializable(typeof(CheckoutUpdateRequest))]
+[JsonSerializable(typeof(CheckoutsCreateApplePaySessionRequest))]
+[JsonSerializable(typeof(CheckoutsListAvailablePaymentMethodsResponse))]
+[JsonSerializable(typeof(CheckoutsListAvailablePaymentMethodsResponseAvailablePaymentMethodsItem))]
+[JsonSerializable(typeof(ClassicMerchantIdentifiers))]
+[JsonSerializable(typeof(Company))]
+[JsonSerializable(typeof(CompanyIdentifier))]
+[JsonSerializable(typeof(CreateReaderCheckoutError))]
+[JsonSerializable(typeof(CreateReaderCheckoutErrorErrors))]
+[JsonSerializable(typeof(CreateReaderCheckoutRequest))]
+[JsonSerializable(typeof(CreateReaderCheckoutRequestAade))]
+[JsonSerializable(typeof(CreateReaderCheckoutRequestAffiliate))]
+[JsonSerializable(typeof(CreateReaderCheckoutRequestCardType))]
+[JsonSerializable(typeof(CreateReaderCheckoutRequestTotalAmount))]
+[JsonSerializable(typeof(CreateReaderCheckoutResponse))]
+[JsonSerializable(typeof(CreateReaderCheckoutResponseData))]
+[JsonSerializable(typeof(CreateReaderCheckoutUnprocessableEntity))]
+[JsonSerializable(typeof(CreateReaderTerminateError))]
+[JsonSerializable(typeof(CreateReaderTerminateErrorErrors))]
+[JsonSerializable(typeof(CreateReaderTerminateUnprocessableEntity))]
+[JsonSerializable(typeof(Currency))]
+[JsonSerializable(typeof(Customer))]
+[JsonSerializable(typeof(CustomersUpdateRequest))]
+[JsonSerializable(typeof(DetailsError))]
+[JsonSerializable(typeof(DetailsErrorFailedConstraintsItem))]
+[JsonSerializable(typeof(Device))]
+[JsonSerializable(typeof(ElvCardAccount))]
+[JsonSerializable(typeof(EntryMode))]
+[JsonSerializable(typeof(Error))]
+[JsonSerializable(typeof(ErrorExtended))]
+[JsonSerializable(typeof(ErrorForbidden))]
+[JsonSerializable(typeof(EventValue))]
+[JsonSerializable(typeof(FinancialPayout))]
+[JsonSerializable(typeof(FinancialPayoutStatus))]
+[JsonSerializable(typeof(FinancialPayoutType))]
+[JsonSerializable(typeof(GetReaderCheckoutResponse))]
+[JsonSerializable(typeof(GetReaderCheckoutResponseData))]
+[JsonSerializable(typeof(GetReaderCheckoutResponseDataCardType))]
+[JsonSerializable(typeof(GetReaderCheckoutResponseDataPaymentType))]
+[JsonSerializable(typeof(GetReaderCheckoutResponseDataStatus))]
+[JsonSerializable(typeof(GetReaderCheckoutResponseDataTotalAmount))]
+[JsonSerializable(typeof(HostedCheckout))]
+[JsonSerializable(typeof(IEnumerable<CheckoutSuccess>))]
+[JsonSerializable(typeof(IEnumerable<ErrorExtended>))]
+[JsonSerializable(typeof(IEnumerable<FinancialPayout>))]
+[JsonSerializable(typeof(IEnumerable<PaymentInstrumentResponse>))]
+[JsonSerializable(typeof(IReadOnlyList<global::SumUp.JsonPatchOperation>))]
+[JsonSerializable(typeof(Invite))]
+[JsonSerializable(typeof(JsonDocument))]
+[JsonSerializable(typeof(global::SumUp.JsonObject))]
+[JsonSerializable(typeof(Link))]
+[JsonSerializable(typeof(ListPersonsResponseBody))]
+[JsonSerializable(typeof(MandateResponse))]
+[JsonSerializable(typeof(MandateResponseStatus))]
+[JsonSerializable(typeof(Member))]
+[JsonSerializable(typeof(MembersCreateRequest))]
+[JsonSerializable(typeof(MembersListResponse))]
+[JsonSerializable(typeof(MembersUpdateRequest))]
+[JsonSerializable(typeof(MembersUpdateRequestUser))]
+[JsonSerializable(typeof(Membership))]
+[JsonSerializable(typeof(MembershipResource))]
+[JsonSerializable(typeof(MembershipStatus))]
+[JsonSerializable(typeof(MembershipUser))]
+[JsonSerializable(typeof(MembershipUserClassic))]
+[JsonSerializable(typeof(MembershipsListResponse))]
+[JsonSerializable(typeof(Merchant))]
+[JsonSerializable(typeof(Meta))]
+[JsonSerializable(typeof(Metadata))]
+[JsonSerializable(typeof(NotFound))]
+[JsonSerializable(typeof(NotFoundErrors))]
+[JsonSerializable(typeof(Ownership))]
+[JsonSerializable(typeof(PaymentInstrumentResponse))]
+[JsonSerializable(typeof(PaymentInstrumentResponseCard))]
+[JsonSerializable(typeof(PaymentInstrumentResponseType))]
+[JsonSerializable(typeof(PaymentType))]
+[JsonSerializable(typeof(Person))]
+[JsonSerializable(typeof(PersonalDetails))]
+[JsonSerializable(typeof(PersonalIdentifier))]
+[JsonSerializable(typeof(Problem))]
+[JsonSerializable(typeof(Product))]
+[JsonSerializable(typeof(Reader))]
+[JsonSerializable(typeof(ReaderCheckoutStatusChange))]
+[JsonSerializable(typeof(ReaderCheckoutStatusChangePayload))]
+[JsonSerializable(typeof(ReaderCheckoutStatusChangePayloadStatus))]
+[JsonSerializable(typeof(ReaderDevice))]
+[JsonSerializable(typeof(ReaderDeviceModel))]
+[JsonSerializable(typeof(ReaderPaymentRequestParams))]
+[JsonSerializable(typeof(ReaderPaymentResponse))]
+[JsonSerializable(typeof(ReaderPaymentResponseData))]
+[JsonSerializable(typeof(ReaderStatus))]
+[JsonSerializable(typeof(ReadersCreateRequest))]
+[JsonSerializable(typeof(ReadersListResponse))]
+[JsonSerializable(typeof(ReadersUpdateRequest))]
+[JsonSerializable(typeof(Receipt))]
+[JsonSerializable(typeof(ReceiptAcquirerData))]
+[JsonSerializable(typeof(ReceiptCard))]
+[JsonSerializable(typeof(ReceiptEvent))]
+[JsonSerializable(typeof(ReceiptMerchantData))]
+[JsonSerializable(typeof(ReceiptMerchantDataMerchantProfile))]
+[JsonSerializable(typeof(ReceiptMerchantDataMerchantProfileAddress))]
+[JsonSerializable(typeof(ReceiptReader))]
+[JsonSerializable(typeof(ReceiptTransaction))]
+[JsonSerializable(typeof(ReceiptTransactionProcessAs))]
+[JsonSerializable(typeof(ReceiptTransactionProductsItem))]
+[JsonSerializable(typeof(ReceiptTransactionVatRatesItem))]
+[JsonSerializable(typeof(Role))]
+[JsonSerializable(typeof(RolesCreateRequest))]
+[JsonSerializable(typeof(RolesListResponse))]
+[JsonSerializable(typeof(RolesUpdateRequest))]
+[JsonSerializable(typeof(StatusResponse))]
+[JsonSerializable(typeof(StatusResponseData))]
+[JsonSerializable(typeof(StatusResponseDataConnectionType))]
+[JsonSerializable(typeof(StatusResponseDataState))]
+[JsonSerializable(typeof(StatusResponseDataStatus))]
+[JsonSerializable(typeof(Timestamps))]
+[JsonSerializable(typeof(TransactionBase))]
+[JsonSerializable(typeof(TransactionCheckoutInfo))]
+[JsonSerializable(typeof(TransactionEvent))]
+[JsonSerializable(typeof(TransactionEventStatus))]
+[JsonSerializable(typeof(TransactionEventType))]
+[JsonSerializable(typeof(TransactionFull))]
+[JsonSerializable(typeof(TransactionFullLocation))]
+[JsonSerializable(typeof(TransactionFullPayoutPlan))]
+[JsonSerializable(typeof(TransactionFullPayoutType))]
+[JsonSerializable(typeof(TransactionFullProcessAs))]
+[JsonSerializable(typeof(TransactionFullSimplePaymentType))]
+[JsonSerializable(typeof(TransactionFullSimpleStatus))]
+[JsonSerializable(typeof(TransactionFullVatRatesItem))]
+[JsonSerializable(typeof(TransactionFullVerificationMethod))]
+[JsonSerializable(typeof(TransactionHistory))]
+[JsonSerializable(typeof(TransactionHistoryPayoutPlan))]
+[JsonSerializable(typeof(TransactionHistoryPayoutType))]
+[JsonSerializable(typeof(TransactionHistoryType))]
+[JsonSerializable(typeof(TransactionMixinHistory))]
+[JsonSerializable(typeof(TransactionMixinHistoryPayoutPlan))]
+[JsonSerializable(typeof(TransactionStatus))]
+[JsonSerializable(typeof(TransactionsHistoryLink))]
+[JsonSerializable(typeof(TransactionsListResponse))]
+[JsonSerializable(typeof(TransactionsRefundRequest))]
+[JsonSerializable(typeof(Unauthorized))]
+[JsonSerializable(typeof(UnauthorizedErrors))]
+[JsonSerializable(typeof(UnauthorizedErrorsType))]
+[JsonSerializable(typeof(UserType))]
+public partial class SumUpJsonContext : JsonSerializerContext
+{
+}
\ No newline at end of file
@@ -75,7 +75,7 @@ public sealed partial class TransactionsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<TransactionFull>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<TransactionFull>());
             return ApiResponse<TransactionFull>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -135,7 +135,7 @@ public sealed partial class TransactionsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<TransactionFull>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<TransactionFull>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<TransactionFull>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -159,7 +159,7 @@ public sealed partial class TransactionsClient
         {
             builder.AddPath("merchant_code", merchantCode);
             builder.AddQuery("transaction_code", operationOptions.TransactionCode);
-            builder.AddQuery("order", operationOptions.Order);
+            builder.AddQuery("order", operationOptions.Order, "ascending");
             builder.AddQuery("limit", operationOptions.Limit);
             builder.AddQuery("users[]", operationOptions.Users);
             builder.AddQuery("statuses[]", operationOptions.Statuses);
@@ -204,7 +204,7 @@ public sealed partial class TransactionsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).GetAwaiter().GetResult();
-            var result = JsonSerializer.Deserialize<TransactionsListResponse>(stream, _client.SerializerOptions);
+            var result = JsonSerializer.Deserialize(stream, _client.GetTypeInfo<TransactionsListResponse>());
             return ApiResponse<TransactionsListResponse>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally
@@ -228,7 +228,7 @@ public sealed partial class TransactionsClient
         {
             builder.AddPath("merchant_code", merchantCode);
             builder.AddQuery("transaction_code", operationOptions.TransactionCode);
-            builder.AddQuery("order", operationOptions.Order);
+            builder.AddQuery("order", operationOptions.Order, "ascending");
             builder.AddQuery("limit", operationOptions.Limit);
             builder.AddQuery("users[]", operationOptions.Users);
             builder.AddQuery("statuses[]", operationOptions.Statuses);
@@ -273,7 +273,7 @@ public sealed partial class TransactionsClient
                 throw new ApiException(response.StatusCode, fallbackError, responseBody, response.RequestMessage?.RequestUri);
             }
             using var stream = await ApiClient.ReadContentAsStreamAsync(response.Content!, effectiveCancellationToken).ConfigureAwait(false);
-            var result = await JsonSerializer.DeserializeAsync<TransactionsListResponse>(stream, _client.SerializerOptions, effectiveCancellationToken).ConfigureAwait(false);
+            var result = await JsonSerializer.DeserializeAsync(stream, _client.GetTypeInfo<TransactionsListResponse>(), effectiveCancellationToken).ConfigureAwait(false);
             return ApiResponse<TransactionsListResponse>.From(result, response.StatusCode, response.Headers, response.RequestMessage?.RequestUri);
         }
         finally